@@ -0,0 +1,4 @@
+data "laravel_forge_nginx_template" "octane" {
+  server_id = 12345
+  name      = "Octane"
+}
@@ -0,0 +1,18 @@
+resource "laravel_forge_backup_configuration" "nightly" {
+  server_id        = 12345
+  storage_provider = "s3"
+  region           = "eu-west-1"
+  bucket           = "acme-db-backups"
+  directory        = "app-production"
+  access_key       = var.backup_access_key
+  secret_key       = var.backup_secret_key
+
+  frequency = "daily"
+  time      = "03:00"
+  retention = 14
+  email     = "ops@example.com"
+
+  databases = [
+    laravel_forge_database.app.id,
+  ]
+}
@@ -0,0 +1,11 @@
+resource "laravel_forge_daemon" "reverb" {
+  server_id = 12345
+  command   = "php artisan reverb:start --port=8080"
+  directory = "/home/forge/example.com/current"
+  user      = "forge"
+  processes = 1
+
+  restart_triggers = {
+    release = "v1.2.3"
+  }
+}
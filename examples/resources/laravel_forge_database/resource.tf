@@ -0,0 +1,4 @@
+resource "laravel_forge_database" "app" {
+  server_id = 12345
+  name      = "app"
+}
@@ -0,0 +1,9 @@
+resource "laravel_forge_database_user" "app" {
+  server_id = 12345
+  name      = "app"
+  password  = var.database_password
+
+  databases = [
+    laravel_forge_database.app.id,
+  ]
+}
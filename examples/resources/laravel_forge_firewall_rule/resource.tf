@@ -0,0 +1,13 @@
+resource "laravel_forge_firewall_rule" "redis" {
+  server_id    = 12345
+  name         = "Redis (private network)"
+  port         = "6379"
+  ip_addresses = ["10.0.0.11", "10.0.0.12"]
+}
+
+resource "laravel_forge_firewall_rule" "vite" {
+  server_id = 12345
+  name      = "Vite dev servers"
+  port      = "5173-5180"
+  type      = "allow"
+}
@@ -0,0 +1,20 @@
+resource "laravel_forge_server" "app" {
+  # ...
+}
+
+resource "laravel_forge_monitor" "disk" {
+  server_id = laravel_forge_server.app.id
+  type      = "disk"
+  operator  = "gte"
+  threshold = 80
+  minutes   = 5
+  notify    = "ops@example.com"
+}
+
+resource "laravel_forge_monitor" "cpu" {
+  server_id = laravel_forge_server.app.id
+  type      = "cpu_load"
+  threshold = 1.5
+  minutes   = 10
+  notify    = "ops@example.com"
+}
@@ -0,0 +1,12 @@
+resource "laravel_forge_nginx_template" "octane" {
+  server_id = 12345
+  name      = "Octane"
+  content   = file("${path.module}/nginx/octane.conf")
+}
+
+resource "laravel_forge_site" "example" {
+  server_id      = 12345
+  domain         = "example.com"
+  project_type   = "php"
+  nginx_template = laravel_forge_nginx_template.octane.id
+}
@@ -0,0 +1,19 @@
+resource "laravel_forge_redirect_rule" "blog" {
+  server_id = 12345
+  site_id   = 67890
+  from      = "/news"
+  to        = "/blog"
+  type      = "permanent"
+}
+
+resource "laravel_forge_redirect_rule" "pricing" {
+  server_id = 12345
+  site_id   = 67890
+  from      = "/pricing-2023"
+  to        = "/pricing"
+  type      = "permanent"
+
+  # Remove every redirect on the site that was added by hand in Forge.
+  authoritative    = true
+  managed_rule_ids = [laravel_forge_redirect_rule.blog.id]
+}
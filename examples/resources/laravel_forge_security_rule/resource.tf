@@ -0,0 +1,16 @@
+resource "laravel_forge_security_rule" "staging" {
+  server_id = 12345
+  site_id   = 67890
+  name      = "Staging"
+
+  credentials = [
+    {
+      username = "qa"
+      password = var.staging_qa_password
+    },
+    {
+      username = "marketing"
+      password = var.staging_marketing_password
+    },
+  ]
+}
@@ -0,0 +1,5 @@
+resource "laravel_forge_server_blackfire" "staging" {
+  server_id           = 12345
+  blackfire_server_id = var.blackfire_server_id
+  server_token        = var.blackfire_server_token
+}
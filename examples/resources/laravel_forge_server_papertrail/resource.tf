@@ -0,0 +1,4 @@
+resource "laravel_forge_server_papertrail" "app" {
+  server_id = 12345
+  host      = "logs.papertrailapp.com:12345"
+}
@@ -0,0 +1,19 @@
+resource "laravel_forge_server_php" "php83" {
+  server_id = 12345
+  version   = "php83"
+  opcache   = true
+
+  # Change the value to upgrade to the latest patch release.
+  upgrade_patch_triggers = {
+    month = "2024-06"
+  }
+}
+
+# Referencing the version makes the site wait for the installation.
+resource "laravel_forge_site" "app" {
+  server_id    = laravel_forge_server_php.php83.server_id
+  domain       = "example.com"
+  project_type = "php"
+  directory    = "/current/public"
+  php_version  = laravel_forge_server_php.php83.version
+}
@@ -0,0 +1,17 @@
+# Worker-only servers don't need a local database.
+resource "laravel_forge_server_service" "mysql" {
+  server_id = 12345
+  service   = "mysql"
+  state     = "stopped"
+}
+
+# Restart PHP-FPM whenever the site's environment changes.
+resource "laravel_forge_server_service" "php" {
+  server_id   = 12345
+  service     = "php"
+  php_version = "php83"
+
+  restart_triggers = {
+    env = laravel_forge_site_env.app.content_hash
+  }
+}
@@ -0,0 +1,19 @@
+resource "laravel_forge_site_balancing" "app" {
+  server_id = laravel_forge_server.balancer.id
+  site_id   = laravel_forge_site.balancer.id
+  method    = "least_conn"
+
+  nodes = [
+    {
+      server_id = laravel_forge_server.web_1.id
+      weight    = 2
+    },
+    {
+      server_id = laravel_forge_server.web_2.id
+    },
+    {
+      server_id = laravel_forge_server.web_3.id
+      backup    = true
+    },
+  ]
+}
@@ -0,0 +1,14 @@
+resource "laravel_forge_site_command" "migrate" {
+  server_id = 12345
+  site_id   = 67890
+  command   = "php artisan migrate --force"
+
+  # Run the migrations again after every new deployment.
+  triggers = {
+    commit = var.release_commit
+  }
+}
+
+output "migrate_output" {
+  value = laravel_forge_site_command.migrate.output
+}
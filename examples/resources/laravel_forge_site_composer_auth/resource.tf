@@ -0,0 +1,15 @@
+resource "laravel_forge_site_composer_auth" "app" {
+  server_id = 12345
+  site_id   = 67890
+
+  credentials = {
+    "nova.laravel.com" = {
+      username = "billing@example.com"
+      password = var.nova_license_key
+    }
+    "satis.example.com" = {
+      username = "deploy"
+      password = var.satis_token
+    }
+  }
+}
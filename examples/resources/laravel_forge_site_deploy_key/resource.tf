@@ -0,0 +1,20 @@
+resource "laravel_forge_site_deploy_key" "app" {
+  server_id = 12345
+  site_id   = 67890
+}
+
+resource "github_repository_deploy_key" "forge" {
+  repository = "app"
+  title      = "Forge (example.com)"
+  key        = laravel_forge_site_deploy_key.app.public_key
+  read_only  = true
+}
+
+resource "laravel_forge_site_repository" "app" {
+  server_id           = laravel_forge_site_deploy_key.app.server_id
+  site_id             = laravel_forge_site_deploy_key.app.site_id
+  repository_provider = "github"
+  repository          = "acme/app"
+
+  depends_on = [github_repository_deploy_key.forge]
+}
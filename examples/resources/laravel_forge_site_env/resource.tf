@@ -0,0 +1,18 @@
+# Manage individual variables and keep everything else in the file.
+resource "laravel_forge_site_env" "app" {
+  server_id = 12345
+  site_id   = 67890
+
+  variables = {
+    APP_ENV     = "production"
+    APP_DEBUG   = "false"
+    DB_PASSWORD = var.db_password
+  }
+}
+
+# Or own the whole file.
+resource "laravel_forge_site_env" "worker" {
+  server_id = 12345
+  site_id   = 67891
+  content   = templatefile("${path.module}/env/worker.env.tftpl", { db_password = var.db_password })
+}
@@ -0,0 +1,12 @@
+resource "laravel_forge_site_integration" "horizon" {
+  server_id = 12345
+  site_id   = 67890
+  type      = "horizon"
+}
+
+resource "laravel_forge_site_integration" "inertia" {
+  server_id       = 12345
+  site_id         = 67890
+  type            = "inertia"
+  deploys_restart = true
+}
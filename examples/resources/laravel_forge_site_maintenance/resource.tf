@@ -0,0 +1,7 @@
+# Keep the site down for as long as this resource exists.
+resource "laravel_forge_site_maintenance" "migration" {
+  server_id = 12345
+  site_id   = 67890
+  secret    = var.maintenance_bypass_secret
+  status    = 503
+}
@@ -0,0 +1,5 @@
+resource "laravel_forge_site_nginx_config" "app" {
+  server_id = 12345
+  site_id   = 67890
+  content   = file("${path.module}/nginx/example.com.conf")
+}
@@ -0,0 +1,6 @@
+resource "laravel_forge_site_octane" "api" {
+  server_id = 12345
+  site_id   = 67890
+  port      = 8000
+  server    = "frankenphp"
+}
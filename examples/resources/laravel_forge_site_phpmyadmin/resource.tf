@@ -0,0 +1,6 @@
+resource "laravel_forge_site_phpmyadmin" "admin" {
+  server_id        = 12345
+  site_id          = 67891
+  database         = laravel_forge_database.app.name
+  database_user_id = laravel_forge_database_user.app.id
+}
@@ -0,0 +1,21 @@
+resource "laravel_forge_site" "app" {
+  server_id    = 12345
+  domain       = "example.com"
+  project_type = "php"
+  directory    = "/public"
+}
+
+resource "laravel_forge_site_repository" "app" {
+  server_id           = laravel_forge_site.app.server_id
+  site_id             = laravel_forge_site.app.id
+  repository_provider = "github"
+  repository          = "acme/app"
+  branch              = "main"
+  composer            = true
+}
+
+resource "laravel_forge_deployment_settings" "app" {
+  server_id    = laravel_forge_site_repository.app.server_id
+  site_id      = laravel_forge_site_repository.app.site_id
+  quick_deploy = true
+}
@@ -0,0 +1,11 @@
+resource "laravel_forge_site_reverb" "app" {
+  server_id   = 12345
+  site_id     = 67890
+  host        = "ws.example.com"
+  port        = 8080
+  connections = 5000
+}
+
+output "reverb_daemon_id" {
+  value = laravel_forge_site_reverb.app.daemon_id
+}
@@ -0,0 +1,8 @@
+resource "laravel_forge_site_scheduler" "app" {
+  server_id = 12345
+  site_id   = 67890
+}
+
+output "scheduler_next_run" {
+  value = laravel_forge_site_scheduler.app.next_run_time
+}
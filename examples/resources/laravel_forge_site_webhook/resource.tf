@@ -0,0 +1,5 @@
+resource "laravel_forge_site_webhook" "slack_relay" {
+  server_id = 12345
+  site_id   = 67890
+  url       = "https://hooks.example.com/forge/deployments"
+}
@@ -0,0 +1,21 @@
+resource "laravel_forge_database" "blog" {
+  server_id = 12345
+  name      = "blog"
+}
+
+resource "laravel_forge_database_user" "blog" {
+  server_id = 12345
+  name      = "blog"
+  password  = var.blog_database_password
+
+  databases = [
+    laravel_forge_database.blog.id,
+  ]
+}
+
+resource "laravel_forge_site_wordpress" "blog" {
+  server_id        = 12345
+  site_id          = 67890
+  database         = laravel_forge_database.blog.name
+  database_user_id = laravel_forge_database_user.blog.id
+}
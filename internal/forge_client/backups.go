@@ -91,10 +91,22 @@ func (c *Client) UpdateBackupConfiguration(ctx context.Context, serverID, backup
 	return &res.Backup, nil
 }
 
+type ErrorBackupConfigurationNotFound struct {
+	ServerID int
+	BackupID int
+}
+
+func (e *ErrorBackupConfigurationNotFound) Error() string {
+	return fmt.Sprintf("backup configuration not found: server=%d, backup=%d", e.ServerID, e.BackupID)
+}
+
 func (c *Client) GetBackupConfiguration(ctx context.Context, serverID, backupID int) (*Backup, error) {
 	path := fmt.Sprintf("/servers/%d/backup-configs/%d", serverID, backupID)
 	var res backupResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &res); err != nil {
+		if _, ok := err.(*ClientErrorResourceNotFound); ok {
+			return nil, &ErrorBackupConfigurationNotFound{ServerID: serverID, BackupID: backupID}
+		}
 		return nil, err
 	}
 	return &res.Backup, nil
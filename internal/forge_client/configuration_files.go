@@ -17,6 +17,17 @@ func (c *Client) GetNginxConfiguration(ctx context.Context, serverID, siteID int
 	return res.Content, nil
 }
 
+func (c *Client) GetNginxConfigurationWithoutCache(ctx context.Context, serverID, siteID int) (string, error) {
+	path := fmt.Sprintf("/servers/%d/sites/%d/nginx", serverID, siteID)
+	var res struct {
+		Content string `json:"content"`
+	}
+	if err := c.GetWithoutCache(ctx, path, &res); err != nil {
+		return "", err
+	}
+	return res.Content, nil
+}
+
 type UpdateConfigurationRequest struct {
 	Content string `json:"content"`
 }
@@ -55,10 +55,22 @@ func (c *Client) ListDaemons(ctx context.Context, serverID int) ([]Daemon, error
 	return resp.Daemons, nil
 }
 
+type ErrorDaemonNotFound struct {
+	ServerID int
+	DaemonID int
+}
+
+func (e *ErrorDaemonNotFound) Error() string {
+	return fmt.Sprintf("daemon not found: server=%d, daemon=%d", e.ServerID, e.DaemonID)
+}
+
 func (c *Client) GetDaemon(ctx context.Context, serverID, daemonID int) (*Daemon, error) {
 	path := fmt.Sprintf("/servers/%d/daemons/%d", serverID, daemonID)
 	var resp DaemonResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		if _, ok := err.(*ClientErrorResourceNotFound); ok {
+			return nil, &ErrorDaemonNotFound{ServerID: serverID, DaemonID: daemonID}
+		}
 		return nil, err
 	}
 	return &resp.Daemon, nil
@@ -46,10 +46,22 @@ func (c *Client) ListDatabaseUsers(ctx context.Context, serverID int) ([]Databas
 	return res.Users, nil
 }
 
+type ErrorDatabaseUserNotFound struct {
+	ServerID int
+	UserID   int
+}
+
+func (e *ErrorDatabaseUserNotFound) Error() string {
+	return fmt.Sprintf("database user not found: server=%d, user=%d", e.ServerID, e.UserID)
+}
+
 func (c *Client) GetDatabaseUser(ctx context.Context, serverID, userID int) (*DatabaseUser, error) {
 	path := fmt.Sprintf("/servers/%d/database-users/%d", serverID, userID)
 	var res databaseUserResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &res); err != nil {
+		if _, ok := err.(*ClientErrorResourceNotFound); ok {
+			return nil, &ErrorDatabaseUserNotFound{ServerID: serverID, UserID: userID}
+		}
 		return nil, err
 	}
 	return &res.User, nil
@@ -50,10 +50,22 @@ func (c *Client) ListDatabases(ctx context.Context, serverID int) ([]Database, e
 	return res.Databases, nil
 }
 
+type ErrorDatabaseNotFound struct {
+	ServerID   int
+	DatabaseID int
+}
+
+func (e *ErrorDatabaseNotFound) Error() string {
+	return fmt.Sprintf("database not found: server=%d, database=%d", e.ServerID, e.DatabaseID)
+}
+
 func (c *Client) GetDatabase(ctx context.Context, serverID, databaseID int) (*Database, error) {
 	path := fmt.Sprintf("/servers/%d/databases/%d", serverID, databaseID)
 	var res databaseResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &res); err != nil {
+		if _, ok := err.(*ClientErrorResourceNotFound); ok {
+			return nil, &ErrorDatabaseNotFound{ServerID: serverID, DatabaseID: databaseID}
+		}
 		return nil, err
 	}
 	return &res.Database, nil
@@ -2,25 +2,48 @@ package forge_client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 )
 
+// FirewallPort holds either a single port ("6379") or a port range ("8000-8010").
+// Forge returns single ports as numbers and ranges as strings.
+type FirewallPort string
+
+func (p *FirewallPort) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var n int64
+	if err := json.Unmarshal(data, &n); err == nil {
+		*p = FirewallPort(strconv.FormatInt(n, 10))
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid firewall port %s: %w", string(data), err)
+	}
+	*p = FirewallPort(s)
+	return nil
+}
+
 type FirewallRule struct {
-	ID        int64   `json:"id"`
-	Name      string  `json:"name"`
-	Port      int     `json:"port"`
-	Type      string  `json:"type"`
-	IpAddress *string `json:"ip_address"`
-	Status    string  `json:"status"`
-	CreatedAt string  `json:"created_at"`
+	ID        int64        `json:"id"`
+	Name      string       `json:"name"`
+	Port      FirewallPort `json:"port"`
+	Type      string       `json:"type"`
+	IpAddress *string      `json:"ip_address"`
+	Status    string       `json:"status"`
+	CreatedAt string       `json:"created_at"`
 }
 
 type CreateFirewallRuleRequest struct {
-	Name      string  `json:"name"`
-	IpAddress *string `json:"ip_address"`
-	Port      int     `json:"port"`
-	Type      string  `json:"type"`
+	Name      string       `json:"name"`
+	IpAddress *string      `json:"ip_address"`
+	Port      FirewallPort `json:"port"`
+	Type      string       `json:"type"`
 }
 
 type FirewallRuleResponse struct {
@@ -49,10 +72,22 @@ func (c *Client) ListFirewallRules(ctx context.Context, serverID int) ([]Firewal
 	return resp.Rules, nil
 }
 
+type ErrorFirewallRuleNotFound struct {
+	ServerID int
+	RuleID   int
+}
+
+func (e *ErrorFirewallRuleNotFound) Error() string {
+	return fmt.Sprintf("firewall rule not found: server=%d, rule=%d", e.ServerID, e.RuleID)
+}
+
 func (c *Client) GetFirewallRule(ctx context.Context, serverID, ruleID int) (*FirewallRule, error) {
 	path := fmt.Sprintf("/servers/%d/firewall-rules/%d", serverID, ruleID)
 	var resp FirewallRuleResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		if _, ok := err.(*ClientErrorResourceNotFound); ok {
+			return nil, &ErrorFirewallRuleNotFound{ServerID: serverID, RuleID: ruleID}
+		}
 		return nil, err
 	}
 	return &resp.Rule, nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type GitProjectRequest struct {
@@ -23,6 +24,30 @@ func (c *Client) UpdateGitProject(ctx context.Context, serverID, siteID int, req
 	return c.doRequest(ctx, http.MethodPut, path, req, nil)
 }
 
+// WaitForGitProjectToBeInstalled polls the site until Forge reports the repository as installed.
+func (c *Client) WaitForGitProjectToBeInstalled(ctx context.Context, serverID, siteID int) (*Site, error) {
+	for {
+		site, err := c.GetSiteWithoutCache(ctx, serverID, siteID)
+		if err != nil {
+			return nil, err
+		}
+		if site.RepositoryStatus != nil {
+			switch *site.RepositoryStatus {
+			case "installed":
+				return site, nil
+			case "failed":
+				return nil, fmt.Errorf("repository installation failed: server=%d, site=%d", serverID, siteID)
+			}
+		}
+		select {
+		case <-time.After(10 * time.Second):
+			// continue polling
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
 func (c *Client) RemoveGitProject(ctx context.Context, serverID, siteID int) error {
 	path := fmt.Sprintf("/servers/%d/sites/%d/git", serverID, siteID)
 	return c.doRequest(ctx, http.MethodDelete, path, nil, nil)
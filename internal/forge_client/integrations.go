@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type IntegrationDaemon struct {
@@ -19,6 +20,49 @@ type IntegrationDaemon struct {
 	CreatedAt    string `json:"created_at"`
 }
 
+// Running reports whether Forge has finished installing the daemon and started it.
+func (d *IntegrationDaemon) Running() bool {
+	return d.Status == "installed" || d.Status == "running"
+}
+
+// Failed reports whether Forge gave up installing the daemon.
+func (d *IntegrationDaemon) Failed() bool {
+	return d.Status == "failed"
+}
+
+// daemonRunning reports whether an enabled integration's daemon is running.
+func daemonRunning(enabled bool, daemon *IntegrationDaemon) bool {
+	return enabled && daemon != nil && daemon.Running()
+}
+
+// daemonFailed reports whether an enabled integration's daemon failed to start.
+func daemonFailed(enabled bool, daemon *IntegrationDaemon) bool {
+	return enabled && daemon != nil && daemon.Failed()
+}
+
+// waitForIntegration polls an integration's status at path until done reports true or returns an error.
+func waitForIntegration[T any](ctx context.Context, c *Client, path string, done func(status *T) (bool, error)) (*T, error) {
+	for {
+		var status T
+		if err := c.GetWithoutCache(ctx, path, &status); err != nil {
+			return nil, err
+		}
+		ok, err := done(&status)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return &status, nil
+		}
+		select {
+		case <-time.After(10 * time.Second):
+			// continue polling
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
 // Horizon.
 type HorizonStatus struct {
 	Enabled          bool               `json:"enabled"`
@@ -89,6 +133,26 @@ func (c *Client) DisableOctane(ctx context.Context, serverID, siteID int) error
 	return c.doRequest(ctx, http.MethodDelete, path, nil, nil)
 }
 
+// WaitForOctaneToBeRunning polls the Octane status until Forge reports its daemon as running.
+func (c *Client) WaitForOctaneToBeRunning(ctx context.Context, serverID, siteID int) (*OctaneStatus, error) {
+	path := fmt.Sprintf("/servers/%d/sites/%d/integrations/octane", serverID, siteID)
+	return waitForIntegration(ctx, c, path, func(status *OctaneStatus) (bool, error) {
+		if daemonFailed(status.Enabled, status.Daemon) {
+			return false, fmt.Errorf("octane daemon failed to start: server=%d, site=%d", serverID, siteID)
+		}
+		return daemonRunning(status.Enabled, status.Daemon), nil
+	})
+}
+
+// WaitForOctaneToBeDisabled polls the Octane status until Forge reports the integration as disabled.
+func (c *Client) WaitForOctaneToBeDisabled(ctx context.Context, serverID, siteID int) error {
+	path := fmt.Sprintf("/servers/%d/sites/%d/integrations/octane", serverID, siteID)
+	_, err := waitForIntegration(ctx, c, path, func(status *OctaneStatus) (bool, error) {
+		return !status.Enabled, nil
+	})
+	return err
+}
+
 // Reverb.
 type ReverbStatus struct {
 	Enabled           bool               `json:"enabled"`
@@ -134,6 +198,26 @@ func (c *Client) DisableReverb(ctx context.Context, serverID, siteID int) error
 	return c.doRequest(ctx, http.MethodDelete, path, nil, nil)
 }
 
+// WaitForReverbToBeRunning polls the Reverb status until Forge reports its daemon as running.
+func (c *Client) WaitForReverbToBeRunning(ctx context.Context, serverID, siteID int) (*ReverbStatus, error) {
+	path := fmt.Sprintf("/servers/%d/sites/%d/integrations/reverb", serverID, siteID)
+	return waitForIntegration(ctx, c, path, func(status *ReverbStatus) (bool, error) {
+		if daemonFailed(status.Enabled, status.Daemon) {
+			return false, fmt.Errorf("reverb daemon failed to start: server=%d, site=%d", serverID, siteID)
+		}
+		return daemonRunning(status.Enabled, status.Daemon), nil
+	})
+}
+
+// WaitForReverbToBeDisabled polls the Reverb status until Forge reports the integration as disabled.
+func (c *Client) WaitForReverbToBeDisabled(ctx context.Context, serverID, siteID int) error {
+	path := fmt.Sprintf("/servers/%d/sites/%d/integrations/reverb", serverID, siteID)
+	_, err := waitForIntegration(ctx, c, path, func(status *ReverbStatus) (bool, error) {
+		return !status.Enabled, nil
+	})
+	return err
+}
+
 // Pulse.
 type PulseStatus struct {
 	Enabled        bool               `json:"enabled"`
@@ -236,19 +320,21 @@ func (c *Client) DisableLaravelMaintenance(ctx context.Context, serverID, siteID
 }
 
 // Laravel Scheduler.
+type LaravelSchedulerJob struct {
+	Command     string `json:"command"`
+	User        string `json:"user"`
+	Frequency   string `json:"frequency"`
+	Cron        string `json:"cron"`
+	Status      string `json:"status"`
+	CreatedAt   string `json:"created_at"`
+	ID          int    `json:"id"`
+	NextRunTime string `json:"next_run_time"`
+}
+
 type LaravelSchedulerStatus struct {
-	Enabled bool `json:"enabled"`
-	Job     *struct {
-		ID          int    `json:"id"`
-		Command     string `json:"command"`
-		User        string `json:"user"`
-		Frequency   string `json:"frequency"`
-		Cron        string `json:"cron"`
-		Status      string `json:"status"`
-		CreatedAt   string `json:"created_at"`
-		NextRunTime string `json:"next_run_time"`
-	} `json:"job"`
-	LaravelInstalled bool `json:"laravel_installed"`
+	Enabled          bool                 `json:"enabled"`
+	Job              *LaravelSchedulerJob `json:"job"`
+	LaravelInstalled bool                 `json:"laravel_installed"`
 }
 
 func (c *Client) CheckLaravelScheduler(ctx context.Context, serverID, siteID int) (*LaravelSchedulerStatus, error) {
@@ -260,17 +346,6 @@ func (c *Client) CheckLaravelScheduler(ctx context.Context, serverID, siteID int
 	return &res, nil
 }
 
-type LaravelSchedulerJob struct {
-	Command     string `json:"command"`
-	User        string `json:"user"`
-	Frequency   string `json:"frequency"`
-	Cron        string `json:"cron"`
-	Status      string `json:"status"`
-	CreatedAt   string `json:"created_at"`
-	ID          int    `json:"id"`
-	NextRunTime string `json:"next_run_time"`
-}
-
 func (c *Client) EnableLaravelScheduler(ctx context.Context, serverID, siteID int) (*LaravelSchedulerJob, error) {
 	path := fmt.Sprintf("/servers/%d/sites/%d/integrations/laravel-scheduler", serverID, siteID)
 	var res struct {
@@ -301,12 +376,23 @@ func (c *Client) GetComposerPackagesAuth(ctx context.Context, serverID, siteID i
 	return &res, nil
 }
 
+func (c *Client) GetComposerPackagesAuthWithoutCache(ctx context.Context, serverID, siteID int) (*ComposerCredentialsResponse, error) {
+	path := fmt.Sprintf("/servers/%d/sites/%d/packages", serverID, siteID)
+	var res ComposerCredentialsResponse
+	if err := c.GetWithoutCache(ctx, path, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+type ComposerPackageCredentialRequest struct {
+	RepositoryURL string `json:"repository_url"`
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+}
+
 type UpdateComposerPackagesAuthRequest struct {
-	Credentials []struct {
-		RepositoryURL string `json:"repository_url"`
-		Username      string `json:"username"`
-		Password      string `json:"password"`
-	} `json:"credentials"`
+	Credentials []ComposerPackageCredentialRequest `json:"credentials"`
 }
 
 func (c *Client) UpdateComposerPackagesAuth(ctx context.Context, serverID, siteID int, req UpdateComposerPackagesAuthRequest) error {
@@ -7,14 +7,14 @@ import (
 )
 
 type Monitor struct {
-	ID             int64  `json:"id"`
-	Status         string `json:"status"`
-	Type           string `json:"type"`
-	Operator       string `json:"operator"`
-	Threshold      int    `json:"threshold"`
-	Minutes        int    `json:"minutes"`
-	State          string `json:"state"`
-	StateChangedAt string `json:"state_changed_at"`
+	ID             int64   `json:"id"`
+	Status         string  `json:"status"`
+	Type           string  `json:"type"`
+	Operator       string  `json:"operator"`
+	Threshold      float64 `json:"threshold"`
+	Minutes        int     `json:"minutes"`
+	State          string  `json:"state"`
+	StateChangedAt string  `json:"state_changed_at"`
 }
 
 type monitorsResponse struct {
@@ -22,11 +22,11 @@ type monitorsResponse struct {
 }
 
 type CreateMonitorRequest struct {
-	Type      string `json:"type"`
-	Operator  string `json:"operator"`
-	Threshold string `json:"threshold"`
-	Minutes   string `json:"minutes"`
-	Notify    string `json:"notify"`
+	Type      string  `json:"type"`
+	Operator  string  `json:"operator"`
+	Threshold float64 `json:"threshold"`
+	Minutes   int     `json:"minutes"`
+	Notify    string  `json:"notify"`
 }
 
 func (c *Client) ListMonitors(ctx context.Context, serverID int) ([]Monitor, error) {
@@ -49,12 +49,24 @@ func (c *Client) CreateMonitor(ctx context.Context, serverID int, req CreateMoni
 	return &res.Monitor, nil
 }
 
+type ErrorMonitorNotFound struct {
+	ServerID  int
+	MonitorID int
+}
+
+func (e *ErrorMonitorNotFound) Error() string {
+	return fmt.Sprintf("monitor not found: server=%d, monitor=%d", e.ServerID, e.MonitorID)
+}
+
 func (c *Client) GetMonitor(ctx context.Context, serverID, monitorID int) (*Monitor, error) {
 	path := fmt.Sprintf("/servers/%d/monitors/%d", serverID, monitorID)
 	var res struct {
 		Monitor Monitor `json:"monitor"`
 	}
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &res); err != nil {
+		if _, ok := err.(*ClientErrorResourceNotFound); ok {
+			return nil, &ErrorMonitorNotFound{ServerID: serverID, MonitorID: monitorID}
+		}
 		return nil, err
 	}
 	return &res.Monitor, nil
@@ -32,7 +32,7 @@ func (c *Client) CreateNginxTemplate(ctx context.Context, serverID int, name, co
 }
 
 func (c *Client) ListNginxTemplates(ctx context.Context, serverID int) ([]NginxTemplate, error) {
-	path := fmt.Sprintf("/servers/%d/nginx/templates/default", serverID)
+	path := fmt.Sprintf("/servers/%d/nginx/templates", serverID)
 	var res nginxTemplatesResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &res); err != nil {
 		return nil, err
@@ -40,10 +40,22 @@ func (c *Client) ListNginxTemplates(ctx context.Context, serverID int) ([]NginxT
 	return res.Templates, nil
 }
 
+type ErrorNginxTemplateNotFound struct {
+	ServerID   int
+	TemplateID int
+}
+
+func (e *ErrorNginxTemplateNotFound) Error() string {
+	return fmt.Sprintf("nginx template not found: server=%d, template=%d", e.ServerID, e.TemplateID)
+}
+
 func (c *Client) GetNginxTemplate(ctx context.Context, serverID, templateID int) (*NginxTemplate, error) {
 	path := fmt.Sprintf("/servers/%d/nginx/templates/%d", serverID, templateID)
 	var res nginxTemplateResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &res); err != nil {
+		if _, ok := err.(*ClientErrorResourceNotFound); ok {
+			return nil, &ErrorNginxTemplateNotFound{ServerID: serverID, TemplateID: templateID}
+		}
 		return nil, err
 	}
 	return &res.Template, nil
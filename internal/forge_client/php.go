@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type PHPVersion struct {
@@ -27,6 +28,84 @@ func (c *Client) ListPHPVersions(ctx context.Context, serverID int) ([]PHPVersio
 	return versions, nil
 }
 
+type ErrorPHPVersionNotFound struct {
+	ServerID int
+	Version  string
+}
+
+func (e *ErrorPHPVersionNotFound) Error() string {
+	return fmt.Sprintf("php version not found: server=%d, version=%s", e.ServerID, e.Version)
+}
+
+// GetPHPVersion returns the PHP version installed on the server with the given version key, e.g. "php83".
+func (c *Client) GetPHPVersion(ctx context.Context, serverID int, version string) (*PHPVersion, error) {
+	versions, err := c.ListPHPVersions(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.Version == version {
+			return &v, nil
+		}
+	}
+	return nil, &ErrorPHPVersionNotFound{ServerID: serverID, Version: version}
+}
+
+// WaitForPHPVersionToBeInstalled polls the server's PHP versions until Forge reports the version as installed.
+func (c *Client) WaitForPHPVersionToBeInstalled(ctx context.Context, serverID int, version string) (*PHPVersion, error) {
+	path := fmt.Sprintf("/servers/%d/php", serverID)
+	for {
+		var versions []PHPVersion
+		if err := c.GetWithoutCache(ctx, path, &versions); err != nil {
+			return nil, err
+		}
+		for _, v := range versions {
+			if v.Version != version {
+				continue
+			}
+			switch v.Status {
+			case "installed":
+				return &v, nil
+			case "failed":
+				return nil, fmt.Errorf("php installation failed: server=%d, version=%s", serverID, version)
+			}
+		}
+		select {
+		case <-time.After(10 * time.Second):
+			// continue polling
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// WaitForPHPVersionToStartUpdating polls the server's PHP versions until Forge no longer reports the version
+// as installed, which it does while a requested upgrade runs. Forge can finish an upgrade between two polls,
+// or skip one that has nothing to do, so waiting stops after a minute without an error.
+func (c *Client) WaitForPHPVersionToStartUpdating(ctx context.Context, serverID int, version string) error {
+	path := fmt.Sprintf("/servers/%d/php", serverID)
+	deadline := time.After(time.Minute)
+	for {
+		var versions []PHPVersion
+		if err := c.GetWithoutCache(ctx, path, &versions); err != nil {
+			return err
+		}
+		for _, v := range versions {
+			if v.Version == version && v.Status != "installed" {
+				return nil
+			}
+		}
+		select {
+		case <-time.After(10 * time.Second):
+			// continue polling
+		case <-deadline:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 type phpVersionRequest struct {
 	Version string `json:"version"`
 }
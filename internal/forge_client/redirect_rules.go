@@ -46,10 +46,32 @@ func (c *Client) ListRedirectRules(ctx context.Context, serverID, siteID int) ([
 	return res.RedirectRules, nil
 }
 
+func (c *Client) ListRedirectRulesWithoutCache(ctx context.Context, serverID, siteID int) ([]RedirectRule, error) {
+	path := fmt.Sprintf("/servers/%d/sites/%d/redirect-rules", serverID, siteID)
+	var res redirectRulesResponse
+	if err := c.GetWithoutCache(ctx, path, &res); err != nil {
+		return nil, err
+	}
+	return res.RedirectRules, nil
+}
+
+type ErrorRedirectRuleNotFound struct {
+	ServerID int
+	SiteID   int
+	RuleID   int
+}
+
+func (e *ErrorRedirectRuleNotFound) Error() string {
+	return fmt.Sprintf("redirect rule not found: server=%d, site=%d, rule=%d", e.ServerID, e.SiteID, e.RuleID)
+}
+
 func (c *Client) GetRedirectRule(ctx context.Context, serverID, siteID, ruleID int) (*RedirectRule, error) {
 	path := fmt.Sprintf("/servers/%d/sites/%d/redirect-rules/%d", serverID, siteID, ruleID)
 	var res redirectRuleResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &res); err != nil {
+		if _, ok := err.(*ClientErrorResourceNotFound); ok {
+			return nil, &ErrorRedirectRuleNotFound{ServerID: serverID, SiteID: siteID, RuleID: ruleID}
+		}
 		return nil, err
 	}
 	return &res.RedirectRule, nil
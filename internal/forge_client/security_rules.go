@@ -28,13 +28,15 @@ type securityRulesResponse struct {
 	SecurityRules []SecurityRule `json:"security_rules"`
 }
 
+type SecurityRuleCredentialRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
 type CreateSecurityRuleRequest struct {
-	Name        string  `json:"name"`
-	Path        *string `json:"path"`
-	Credentials []struct {
-		Username string `json:"username"`
-		Password string `json:"password"`
-	} `json:"credentials"`
+	Name        string                          `json:"name"`
+	Path        *string                         `json:"path"`
+	Credentials []SecurityRuleCredentialRequest `json:"credentials"`
 }
 
 func (c *Client) CreateSecurityRule(ctx context.Context, serverID, siteID int, req CreateSecurityRuleRequest) (*SecurityRule, error) {
@@ -55,10 +57,23 @@ func (c *Client) ListSecurityRules(ctx context.Context, serverID, siteID int) ([
 	return res.SecurityRules, nil
 }
 
+type ErrorSecurityRuleNotFound struct {
+	ServerID int
+	SiteID   int
+	RuleID   int
+}
+
+func (e *ErrorSecurityRuleNotFound) Error() string {
+	return fmt.Sprintf("security rule not found: server=%d, site=%d, rule=%d", e.ServerID, e.SiteID, e.RuleID)
+}
+
 func (c *Client) GetSecurityRule(ctx context.Context, serverID, siteID, ruleID int) (*SecurityRule, error) {
 	path := fmt.Sprintf("/servers/%d/sites/%d/security-rules/%d", serverID, siteID, ruleID)
 	var res securityRuleResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &res); err != nil {
+		if _, ok := err.(*ClientErrorResourceNotFound); ok {
+			return nil, &ErrorSecurityRuleNotFound{ServerID: serverID, SiteID: siteID, RuleID: ruleID}
+		}
 		return nil, err
 	}
 	return &res.SecurityRule, nil
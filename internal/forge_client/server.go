@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -262,10 +263,16 @@ type testNginxResponse struct {
 	Result string `json:"result"`
 }
 
+// Successful reports whether the `nginx -t` output indicates a valid configuration.
+func (r *testNginxResponse) Successful() bool {
+	return !strings.Contains(r.Result, "[emerg]") && !strings.Contains(r.Result, "test failed")
+}
+
 func (c *Client) TestNginx(ctx context.Context, serverID int) (*testNginxResponse, error) {
 	path := fmt.Sprintf("/servers/%d/nginx/test", serverID)
 	var resp testNginxResponse
-	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	// Never serve a cached result; the configuration may have changed since the last test.
+	if err := c.GetWithoutCache(ctx, path, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
@@ -296,9 +303,11 @@ type installBlackfireRequest struct {
 	ServerToken string `json:"server_token"`
 }
 
-func (c *Client) InstallBlackfire(ctx context.Context, serverID int, serverToken string) error {
+// InstallBlackfire installs the Blackfire agent using the server ID and token of a Blackfire environment,
+// which are unrelated to the Forge server ID.
+func (c *Client) InstallBlackfire(ctx context.Context, serverID int, blackfireServerID, serverToken string) error {
 	path := fmt.Sprintf("/servers/%d/blackfire/install", serverID)
-	req := installBlackfireRequest{ServerID: fmt.Sprint(serverID), ServerToken: serverToken}
+	req := installBlackfireRequest{ServerID: blackfireServerID, ServerToken: serverToken}
 	return c.doRequest(ctx, http.MethodPost, path, req, nil)
 }
 
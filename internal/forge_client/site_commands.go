@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type Command struct {
@@ -56,3 +57,69 @@ func (c *Client) GetSiteCommand(ctx context.Context, serverID, siteID, commandID
 	}
 	return &res.Command, res.Output, nil
 }
+
+// Finished reports whether the command has stopped running, successfully or not.
+func (cmd *Command) Finished() bool {
+	return cmd.Status != "waiting" && cmd.Status != "running"
+}
+
+// Successful reports whether the command ran to completion.
+func (cmd *Command) Successful() bool {
+	return cmd.Status == "finished"
+}
+
+func (c *Client) ListSiteCommandsWithoutCache(ctx context.Context, serverID, siteID int) ([]Command, error) {
+	path := fmt.Sprintf("/servers/%d/sites/%d/commands", serverID, siteID)
+	var res commandsResponse
+	if err := c.GetWithoutCache(ctx, path, &res); err != nil {
+		return nil, err
+	}
+	return res.Commands, nil
+}
+
+// WaitForSiteCommandToBeCreated polls the site's commands until one running the given command line
+// appears with an ID greater than afterID. ExecuteSiteCommand does not return the command it creates.
+func (c *Client) WaitForSiteCommandToBeCreated(ctx context.Context, serverID, siteID int, command string, afterID int64) (*Command, error) {
+	for {
+		commands, err := c.ListSiteCommandsWithoutCache(ctx, serverID, siteID)
+		if err != nil {
+			return nil, err
+		}
+		var found *Command
+		for i := range commands {
+			cmd := &commands[i]
+			if cmd.ID > afterID && cmd.Command == command && (found == nil || cmd.ID < found.ID) {
+				found = cmd
+			}
+		}
+		if found != nil {
+			return found, nil
+		}
+		select {
+		case <-time.After(10 * time.Second):
+			// continue polling
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// WaitForSiteCommandToFinish polls the command until it stops running and returns it with its output.
+func (c *Client) WaitForSiteCommandToFinish(ctx context.Context, serverID, siteID, commandID int) (*Command, string, error) {
+	path := fmt.Sprintf("/servers/%d/sites/%d/commands/%d", serverID, siteID, commandID)
+	for {
+		var res commandResponse
+		if err := c.GetWithoutCache(ctx, path, &res); err != nil {
+			return nil, "", err
+		}
+		if res.Command.Finished() {
+			return &res.Command, res.Output, nil
+		}
+		select {
+		case <-time.After(10 * time.Second):
+			// continue polling
+		case <-ctx.Done():
+			return nil, "", ctx.Err()
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 )
 
 type Site struct {
@@ -80,6 +82,15 @@ func (c *Client) GetSite(ctx context.Context, serverID, siteID int) (*Site, erro
 	return &res.Site, nil
 }
 
+func (c *Client) GetSiteWithoutCache(ctx context.Context, serverID, siteID int) (*Site, error) {
+	path := fmt.Sprintf("/servers/%d/sites/%d", serverID, siteID)
+	var res siteResponse
+	if err := c.GetWithoutCache(ctx, path, &res); err != nil {
+		return nil, err
+	}
+	return &res.Site, nil
+}
+
 type UpdateSiteRequest struct {
 	Directory  string   `json:"directory"`
 	Name       string   `json:"name"`
@@ -126,7 +137,32 @@ func (c *Client) DeleteSite(ctx context.Context, serverID, siteID int) error {
 	return c.doRequest(ctx, http.MethodDelete, path, nil, nil)
 }
 
-type balancingNode struct {
+// WaitForAppToBeInstalled polls the site until Forge reports the given one-click application, such as
+// WordPress or phpMyAdmin, as installed. The status of any other application on the site is ignored.
+func (c *Client) WaitForAppToBeInstalled(ctx context.Context, serverID, siteID int, app string) (*Site, error) {
+	for {
+		site, err := c.GetSiteWithoutCache(ctx, serverID, siteID)
+		if err != nil {
+			return nil, err
+		}
+		if site.App != nil && strings.EqualFold(*site.App, app) && site.AppStatus != nil {
+			switch *site.AppStatus {
+			case "installed":
+				return site, nil
+			case "failed":
+				return nil, fmt.Errorf("%s installation failed: server=%d, site=%d", app, serverID, siteID)
+			}
+		}
+		select {
+		case <-time.After(10 * time.Second):
+			// continue polling
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+type BalancingNode struct {
 	ServerID int   `json:"server_id"`
 	Weight   *int  `json:"weight,omitempty"`
 	Down     *bool `json:"down,omitempty"`
@@ -135,15 +171,15 @@ type balancingNode struct {
 }
 
 type UpdateBalancingRequest struct {
-	Servers []balancingNode `json:"servers"`
+	Servers []BalancingNode `json:"servers"`
 	Method  string          `json:"method"`
 }
 
 type balancingResponse struct {
-	Nodes []balancingNode `json:"nodes"`
+	Nodes []BalancingNode `json:"nodes"`
 }
 
-func (c *Client) GetSiteBalancing(ctx context.Context, serverID, siteID int) ([]balancingNode, error) {
+func (c *Client) GetSiteBalancing(ctx context.Context, serverID, siteID int) ([]BalancingNode, error) {
 	path := fmt.Sprintf("/servers/%d/sites/%d/balancing", serverID, siteID)
 	var res balancingResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &res); err != nil {
@@ -29,30 +29,39 @@ func (c *Client) ListWebhooks(ctx context.Context, serverID, siteID int) ([]Webh
 	return res.Webhooks, nil
 }
 
+type ErrorWebhookNotFound struct {
+	ServerID  int
+	SiteID    int
+	WebhookID int
+}
+
+func (e *ErrorWebhookNotFound) Error() string {
+	return fmt.Sprintf("webhook not found: server=%d, site=%d, webhook=%d", e.ServerID, e.SiteID, e.WebhookID)
+}
+
 func (c *Client) GetWebhook(ctx context.Context, serverID, siteID, webhookID int) (*Webhook, error) {
 	path := fmt.Sprintf("/servers/%d/sites/%d/webhooks/%d", serverID, siteID, webhookID)
 	var res webhookResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &res); err != nil {
+		if _, ok := err.(*ClientErrorResourceNotFound); ok {
+			return nil, &ErrorWebhookNotFound{ServerID: serverID, SiteID: siteID, WebhookID: webhookID}
+		}
 		return nil, err
 	}
 	return &res.Webhook, nil
 }
 
-func (c *Client) CreateWebhook(ctx context.Context, serverID, siteID int, urlStr string) (string, error) {
+func (c *Client) CreateWebhook(ctx context.Context, serverID, siteID int, urlStr string) (*Webhook, error) {
 	path := fmt.Sprintf("/servers/%d/sites/%d/webhooks", serverID, siteID)
 	req := map[string]string{"url": urlStr}
-	var res map[string]string
+	var res webhookResponse
 	if err := c.doRequest(ctx, http.MethodPost, path, req, &res); err != nil {
-		return "", err
+		return nil, err
 	}
-	return res["url"], nil
+	return &res.Webhook, nil
 }
 
-func (c *Client) DeleteWebhook(ctx context.Context, serverID, siteID, webhookID int) (string, error) {
+func (c *Client) DeleteWebhook(ctx context.Context, serverID, siteID, webhookID int) error {
 	path := fmt.Sprintf("/servers/%d/sites/%d/webhooks/%d", serverID, siteID, webhookID)
-	var res map[string]string
-	if err := c.doRequest(ctx, http.MethodDelete, path, nil, &res); err != nil {
-		return "", err
-	}
-	return res["url"], nil
+	return c.doRequest(ctx, http.MethodDelete, path, nil, nil)
 }
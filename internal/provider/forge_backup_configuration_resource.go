@@ -0,0 +1,527 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeBackupConfigurationResource{}
+var _ resource.ResourceWithImportState = &ForgeBackupConfigurationResource{}
+
+// backupArchiveType is the object type of a single entry in the `backups` list.
+var backupArchiveType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":           types.Int64Type,
+		"status":       types.StringType,
+		"archive_path": types.StringType,
+		"duration":     types.Int64Type,
+		"date":         types.StringType,
+	},
+}
+
+// ForgeBackupConfigurationResource implements a Terraform resource for a Forge database backup configuration.
+type ForgeBackupConfigurationResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeBackupConfigurationResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	ServerID        types.Int64  `tfsdk:"server_id"`
+	StorageProvider types.String `tfsdk:"storage_provider"`
+	Region          types.String `tfsdk:"region"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Bucket          types.String `tfsdk:"bucket"`
+	AccessKey       types.String `tfsdk:"access_key"`
+	SecretKey       types.String `tfsdk:"secret_key"`
+	Directory       types.String `tfsdk:"directory"`
+	Email           types.String `tfsdk:"email"`
+	Frequency       types.String `tfsdk:"frequency"`
+	Time            types.String `tfsdk:"time"`
+	Day             types.Int64  `tfsdk:"day"`
+	Custom          types.String `tfsdk:"custom"`
+	Retention       types.Int64  `tfsdk:"retention"`
+	Databases       types.Set    `tfsdk:"databases"`
+	Status          types.String `tfsdk:"status"`
+	LastBackupTime  types.String `tfsdk:"last_backup_time"`
+	Backups         types.List   `tfsdk:"backups"`
+}
+
+type ForgeBackupArchiveModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Status      types.String `tfsdk:"status"`
+	ArchivePath types.String `tfsdk:"archive_path"`
+	Duration    types.Int64  `tfsdk:"duration"`
+	Date        types.String `tfsdk:"date"`
+}
+
+func NewForgeBackupConfigurationResource() resource.Resource {
+	return &ForgeBackupConfigurationResource{}
+}
+
+func (r *ForgeBackupConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_backup_configuration"
+}
+
+func (r *ForgeBackupConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge backup configuration resource. This resource allows you to manage scheduled database backups on Forge servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server whose databases are backed up.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"storage_provider": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The storage provider. Valid values are `s3`, `spaces` and `custom`.",
+			},
+			"region": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The region of the bucket.",
+			},
+			"endpoint": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The endpoint of the storage service. Required for the `spaces` and `custom` storage providers.",
+			},
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The bucket the backups are stored in.",
+			},
+			"access_key": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access key of the storage provider.",
+			},
+			"secret_key": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key of the storage provider.",
+			},
+			"directory": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The directory within the bucket the backups are stored in.",
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The email address to notify when a backup fails.",
+			},
+			"frequency": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("daily"),
+				MarkdownDescription: "How often backups run. Valid values are `hourly`, `daily`, `weekly` and `custom`. Default is 'daily'.",
+			},
+			"time": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The time of day backups run, e.g. `03:00`. Required for `daily` and `weekly` frequencies.",
+			},
+			"day": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The day of the week backups run, from 0 (Sunday) to 6 (Saturday). Required for the `weekly` frequency.",
+			},
+			"custom": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A cron expression for the schedule. Required for the `custom` frequency.",
+			},
+			"retention": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(7),
+				MarkdownDescription: "The number of backups to keep. Default is 7.",
+			},
+			"databases": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Required:            true,
+				MarkdownDescription: "The IDs of the databases to back up.",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"last_backup_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time of the most recent backup.",
+			},
+			"backups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The recent backup archives reported by Forge.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":           schema.Int64Attribute{Computed: true},
+						"status":       schema.StringAttribute{Computed: true},
+						"archive_path": schema.StringAttribute{Computed: true},
+						"duration":     schema.Int64Attribute{Computed: true},
+						"date":         schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeBackupConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeBackupConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeBackupConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildBackupConfigurationRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.CreateBackupConfiguration(ctx, int(plan.ServerID.ValueInt64()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating backup configuration", err.Error())
+		return
+	}
+
+	diags = setForgeBackupConfigurationState(ctx, &plan, backup)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeBackupConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeBackupConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.GetBackupConfiguration(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorBackupConfigurationNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading backup configuration", err.Error())
+		return
+	}
+
+	diags = setForgeBackupConfigurationState(ctx, &state, backup)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeBackupConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ForgeBackupConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildBackupConfigurationRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.UpdateBackupConfiguration(ctx, int(plan.ServerID.ValueInt64()), int(plan.ID.ValueInt64()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating backup configuration", err.Error())
+		return
+	}
+
+	diags = setForgeBackupConfigurationState(ctx, &plan, backup)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeBackupConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeBackupConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBackupConfiguration(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting backup configuration", err.Error())
+		return
+	}
+}
+
+func (r *ForgeBackupConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:backup_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:backup_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	backupID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid backup_id", err.Error())
+		return
+	}
+
+	backup, err := r.client.GetBackupConfiguration(ctx, int(serverID), int(backupID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading backup configuration", err.Error())
+		return
+	}
+
+	// Storage credentials and the remaining settings are not returned by the API; they are
+	// sent again from the configuration on the next apply.
+	stateModel := ForgeBackupConfigurationResourceModel{
+		ServerID:  types.Int64Value(serverID),
+		Region:    types.StringNull(),
+		Endpoint:  types.StringNull(),
+		Bucket:    types.StringNull(),
+		AccessKey: types.StringNull(),
+		SecretKey: types.StringNull(),
+		Directory: types.StringNull(),
+		Email:     types.StringNull(),
+		Frequency: types.StringNull(),
+		Custom:    types.StringNull(),
+		Retention: types.Int64Null(),
+	}
+	diags := setForgeBackupConfigurationState(ctx, &stateModel, backup)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// buildBackupConfigurationRequest validates the schedule and builds the payload shared by create and update.
+func buildBackupConfigurationRequest(ctx context.Context, plan ForgeBackupConfigurationResourceModel) (forge_client.CreateBackupConfigurationRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch plan.StorageProvider.ValueString() {
+	case "s3", "spaces", "custom":
+	default:
+		diags.AddError("Invalid storage_provider", fmt.Sprintf("Expected 's3', 'spaces' or 'custom', got: %q", plan.StorageProvider.ValueString()))
+		return forge_client.CreateBackupConfigurationRequest{}, diags
+	}
+
+	frequency := forge_client.BackupFrequency{
+		Type: plan.Frequency.ValueString(),
+	}
+	switch frequency.Type {
+	case "hourly":
+	case "daily", "weekly":
+		if plan.Time.IsNull() || plan.Time.ValueString() == "" {
+			diags.AddError("Time required", fmt.Sprintf("The 'time' attribute is required for the %q frequency.", frequency.Type))
+			return forge_client.CreateBackupConfigurationRequest{}, diags
+		}
+		frequency.Time = plan.Time.ValueString()
+		if frequency.Type == "weekly" {
+			if plan.Day.IsNull() {
+				diags.AddError("Day required", "The 'day' attribute is required for the \"weekly\" frequency.")
+				return forge_client.CreateBackupConfigurationRequest{}, diags
+			}
+			day := int(plan.Day.ValueInt64())
+			frequency.Day = &day
+		}
+	case "custom":
+		if plan.Custom.IsNull() || plan.Custom.ValueString() == "" {
+			diags.AddError("Custom schedule required", "The 'custom' attribute is required for the \"custom\" frequency.")
+			return forge_client.CreateBackupConfigurationRequest{}, diags
+		}
+		frequency.Custom = plan.Custom.ValueString()
+	default:
+		diags.AddError("Invalid frequency", fmt.Sprintf("Expected 'hourly', 'daily', 'weekly' or 'custom', got: %q", frequency.Type))
+		return forge_client.CreateBackupConfigurationRequest{}, diags
+	}
+
+	var databases []int64
+	diags.Append(plan.Databases.ElementsAs(ctx, &databases, false)...)
+	if diags.HasError() {
+		return forge_client.CreateBackupConfigurationRequest{}, diags
+	}
+	if len(databases) == 0 {
+		diags.AddError("Databases required", "At least one database must be backed up.")
+		return forge_client.CreateBackupConfigurationRequest{}, diags
+	}
+
+	payload := forge_client.CreateBackupConfigurationRequest{
+		Provider: plan.StorageProvider.ValueString(),
+		Credentials: forge_client.BackupCredentials{
+			Endpoint:  plan.Endpoint.ValueString(),
+			Region:    plan.Region.ValueString(),
+			Bucket:    plan.Bucket.ValueString(),
+			AccessKey: plan.AccessKey.ValueString(),
+			SecretKey: plan.SecretKey.ValueString(),
+		},
+		Frequency: frequency,
+		Directory: plan.Directory.ValueString(),
+		Email:     plan.Email.ValueString(),
+		Retention: int(plan.Retention.ValueInt64()),
+	}
+	for _, id := range databases {
+		payload.Databases = append(payload.Databases, int(id))
+	}
+
+	return payload, diags
+}
+
+// setForgeBackupConfigurationState copies the attributes returned by the API onto the model.
+func setForgeBackupConfigurationState(ctx context.Context, model *ForgeBackupConfigurationResourceModel, backup *forge_client.Backup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.Int64Value(backup.ID)
+	model.StorageProvider = types.StringValue(backup.Provider)
+	model.Status = types.StringValue(backup.Status)
+
+	// The API returns the time and day of the schedule but not its frequency, which is inferred from them.
+	// Hourly and custom schedules have neither, so their frequency is left as it is.
+	if model.Frequency.ValueString() != "custom" {
+		switch {
+		case backup.DayOfWeek != nil:
+			model.Frequency = types.StringValue("weekly")
+		case backup.Time != nil:
+			model.Frequency = types.StringValue("daily")
+		}
+		if backup.Time != nil {
+			// Keep the configured time if it is the same time of day written differently, e.g. `3:00`.
+			if !backupTimesEqual(model.Time.ValueString(), *backup.Time) {
+				model.Time = types.StringValue(normalizeBackupTime(*backup.Time))
+			}
+		} else {
+			model.Time = types.StringNull()
+		}
+		if backup.DayOfWeek != nil {
+			model.Day = types.Int64Value(int64(*backup.DayOfWeek))
+		} else {
+			model.Day = types.Int64Null()
+		}
+	}
+
+	if backup.LastBackupTime != nil {
+		model.LastBackupTime = types.StringValue(*backup.LastBackupTime)
+	} else {
+		model.LastBackupTime = types.StringNull()
+	}
+
+	databases := make([]int64, 0, len(backup.Databases))
+	for _, d := range backup.Databases {
+		databases = append(databases, d.ID)
+	}
+	databaseSet, d := types.SetValueFrom(ctx, types.Int64Type, databases)
+	diags.Append(d...)
+	model.Databases = databaseSet
+
+	archives := make([]ForgeBackupArchiveModel, 0, len(backup.Backups))
+	for _, b := range backup.Backups {
+		archives = append(archives, ForgeBackupArchiveModel{
+			ID:          types.Int64Value(b.ID),
+			Status:      types.StringValue(b.Status),
+			ArchivePath: types.StringValue(b.ArchivePath),
+			Duration:    types.Int64Value(int64(b.Duration)),
+			Date:        types.StringValue(b.Date),
+		})
+	}
+	archiveList, d := types.ListValueFrom(ctx, backupArchiveType, archives)
+	diags.Append(d...)
+	model.Backups = archiveList
+
+	return diags
+}
+
+// normalizeBackupTime drops the seconds from a time returned by the API, e.g. `03:00:00`, to match the configured `03:00`.
+func normalizeBackupTime(t string) string {
+	if len(t) == len("15:04:05") && strings.HasSuffix(t, ":00") {
+		return t[:len("15:04")]
+	}
+	return t
+}
+
+// backupTimesEqual reports whether two times, with or without seconds, are the same time of day.
+func backupTimesEqual(a, b string) bool {
+	ta, ok := parseBackupTime(a)
+	if !ok {
+		return false
+	}
+	tb, ok := parseBackupTime(b)
+	return ok && ta.Equal(tb)
+}
+
+func parseBackupTime(t string) (time.Time, bool) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		if parsed, err := time.Parse(layout, t); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,328 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeDaemonResource{}
+var _ resource.ResourceWithImportState = &ForgeDaemonResource{}
+
+// ForgeDaemonResource implements a Terraform resource for a Forge daemon.
+type ForgeDaemonResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeDaemonResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	ServerID        types.Int64  `tfsdk:"server_id"`
+	Command         types.String `tfsdk:"command"`
+	User            types.String `tfsdk:"user"`
+	Directory       types.String `tfsdk:"directory"`
+	Processes       types.Int64  `tfsdk:"processes"`
+	StartSecs       types.Int64  `tfsdk:"start_secs"`
+	StopWaitSecs    types.Int64  `tfsdk:"stop_wait_secs"`
+	StopSignal      types.String `tfsdk:"stop_signal"`
+	RestartTriggers types.Map    `tfsdk:"restart_triggers"`
+	Status          types.String `tfsdk:"status"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+func NewForgeDaemonResource() resource.Resource {
+	return &ForgeDaemonResource{}
+}
+
+func (r *ForgeDaemonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_daemon"
+}
+
+func (r *ForgeDaemonResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge daemon resource. This resource allows you to manage long-running processes supervised by Forge on a server.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server where the daemon runs.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"command": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The command the daemon runs, e.g. `php artisan reverb:start`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("forge"),
+				MarkdownDescription: "The user the daemon runs as. Default is 'forge'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The directory the command is run from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"processes": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "The number of processes to run. Default is 1.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"start_secs": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "The number of seconds the process must stay up to be considered started. Default is 1 second.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"stop_wait_secs": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				MarkdownDescription: "The number of seconds to wait for the process to stop before it is killed. Default is 5 seconds.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"stop_signal": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("SIGTERM"),
+				MarkdownDescription: "The signal used to stop the process. Default is 'SIGTERM'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"restart_triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary map of values that, when changed, restarts the daemon.",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeDaemonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeDaemonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeDaemonResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the CreateDaemonRequest payload.
+	payload := forge_client.CreateDaemonRequest{
+		Command:      plan.Command.ValueString(),
+		User:         plan.User.ValueString(),
+		Directory:    plan.Directory.ValueString(),
+		Processes:    int(plan.Processes.ValueInt64()),
+		StartSecs:    int(plan.StartSecs.ValueInt64()),
+		StopWaitSecs: int(plan.StopWaitSecs.ValueInt64()),
+		StopSignal:   plan.StopSignal.ValueString(),
+	}
+
+	daemon, err := r.client.CreateDaemon(ctx, int(plan.ServerID.ValueInt64()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating daemon", err.Error())
+		return
+	}
+
+	plan.ID = types.Int64Value(daemon.ID)
+	setForgeDaemonState(&plan, daemon)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeDaemonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeDaemonResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	daemon, err := r.client.GetDaemon(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorDaemonNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading daemon", err.Error())
+		return
+	}
+
+	setForgeDaemonState(&state, daemon)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeDaemonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ForgeDaemonResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ForgeDaemonResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other attribute forces a replacement, so the only in-place change
+	// is to the restart triggers.
+	if !plan.RestartTriggers.Equal(state.RestartTriggers) {
+		err := r.client.RestartDaemon(ctx, int(plan.ServerID.ValueInt64()), int(plan.ID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Error restarting daemon", err.Error())
+			return
+		}
+	}
+
+	daemon, err := r.client.GetDaemon(ctx, int(plan.ServerID.ValueInt64()), int(plan.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading daemon", err.Error())
+		return
+	}
+
+	setForgeDaemonState(&plan, daemon)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeDaemonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeDaemonResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDaemon(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting daemon", err.Error())
+		return
+	}
+}
+
+func (r *ForgeDaemonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:daemon_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:daemon_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	daemonID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid daemon_id", err.Error())
+		return
+	}
+
+	daemon, err := r.client.GetDaemon(ctx, int(serverID), int(daemonID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading daemon", err.Error())
+		return
+	}
+
+	var stateModel ForgeDaemonResourceModel
+	stateModel.ID = types.Int64Value(daemon.ID)
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.RestartTriggers = types.MapNull(types.StringType)
+	setForgeDaemonState(&stateModel, daemon)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setForgeDaemonState copies the API representation of a daemon onto the model.
+func setForgeDaemonState(model *ForgeDaemonResourceModel, daemon *forge_client.Daemon) {
+	model.Command = types.StringValue(daemon.Command)
+	model.User = types.StringValue(daemon.User)
+	model.Directory = types.StringValue(daemon.Directory)
+	model.Processes = types.Int64Value(int64(daemon.Processes))
+	model.StartSecs = types.Int64Value(int64(daemon.StartSecs))
+	model.StopWaitSecs = types.Int64Value(int64(daemon.StopWaitSecs))
+	model.StopSignal = types.StringValue(daemon.StopSignal)
+	model.Status = types.StringValue(daemon.Status)
+	model.CreatedAt = types.StringValue(daemon.CreatedAt)
+}
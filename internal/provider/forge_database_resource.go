@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeDatabaseResource{}
+var _ resource.ResourceWithImportState = &ForgeDatabaseResource{}
+
+// databaseImportedKey is the private state key marking a database as imported until its first apply.
+const databaseImportedKey = "imported"
+
+// ForgeDatabaseResource implements a Terraform resource for a Forge database.
+type ForgeDatabaseResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeDatabaseResourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	ServerID  types.Int64  `tfsdk:"server_id"`
+	Name      types.String `tfsdk:"name"`
+	User      types.String `tfsdk:"user"`
+	Password  types.String `tfsdk:"password"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func NewForgeDatabaseResource() resource.Resource {
+	return &ForgeDatabaseResource{}
+}
+
+func (r *ForgeDatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_database"
+}
+
+func (r *ForgeDatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The API never returns the user created with the database, so imported databases adopt the configured one.
+	userImported := func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		imported, diags := req.Private.GetKey(ctx, databaseImportedKey)
+		resp.Diagnostics.Append(diags...)
+		resp.RequiresReplace = len(imported) == 0
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge database resource. This resource allows you to manage databases on Forge servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the database is created on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The name of a database user to create together with the database. Use `laravel_forge_database_user` to manage users independently. " +
+					"Imported databases adopt the configured user without being recreated.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						userImported,
+						"Changing the user recreates the database, except on the first apply after an import.",
+						"Changing the user recreates the database, except on the first apply after an import.",
+					),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password of the user created together with the database. Required if `user` is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						userImported,
+						"Changing the password recreates the database, except on the first apply after an import.",
+						"Changing the password recreates the database, except on the first apply after an import.",
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeDatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeDatabaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.User.IsNull() && plan.Password.IsNull() {
+		resp.Diagnostics.AddError("Password required", "A password must be provided when a user is created together with the database.")
+		return
+	}
+
+	// Build the CreateDatabaseRequest payload.
+	payload := forge_client.CreateDatabaseRequest{
+		Name:     plan.Name.ValueString(),
+		User:     plan.User.ValueString(),
+		Password: plan.Password.ValueString(),
+	}
+
+	database, err := r.client.CreateDatabase(ctx, int(plan.ServerID.ValueInt64()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating database", err.Error())
+		return
+	}
+
+	plan.ID = types.Int64Value(database.ID)
+	plan.Name = types.StringValue(database.Name)
+	plan.Status = types.StringValue(database.Status)
+	plan.CreatedAt = types.StringValue(database.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeDatabaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorDatabaseNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading database", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(database.Name)
+	state.Status = types.StringValue(database.Status)
+	state.CreatedAt = types.StringValue(database.CreatedAt)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No update API exists for databases; changing the user recreates it, except on the first apply after an import.
+	var plan ForgeDatabaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	// The configured user has been adopted; later changes recreate the database.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, databaseImportedKey, nil)...)
+}
+
+func (r *ForgeDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeDatabaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDatabase(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting database", err.Error())
+		return
+	}
+}
+
+func (r *ForgeDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:database_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:database_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	databaseID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid database_id", err.Error())
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, int(serverID), int(databaseID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading database", err.Error())
+		return
+	}
+
+	var stateModel ForgeDatabaseResourceModel
+	stateModel.ID = types.Int64Value(database.ID)
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.Name = types.StringValue(database.Name)
+	stateModel.User = types.StringNull()
+	stateModel.Password = types.StringNull()
+	stateModel.Status = types.StringValue(database.Status)
+	stateModel.CreatedAt = types.StringValue(database.CreatedAt)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, databaseImportedKey, []byte("true"))...)
+}
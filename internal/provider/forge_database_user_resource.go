@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeDatabaseUserResource{}
+var _ resource.ResourceWithImportState = &ForgeDatabaseUserResource{}
+
+// ForgeDatabaseUserResource implements a Terraform resource for a Forge database user.
+type ForgeDatabaseUserResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeDatabaseUserResourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	ServerID      types.Int64  `tfsdk:"server_id"`
+	Name          types.String `tfsdk:"name"`
+	Password      types.String `tfsdk:"password"`
+	Databases     types.Set    `tfsdk:"databases"`
+	SyncDatabases types.Bool   `tfsdk:"sync_databases"`
+	Status        types.String `tfsdk:"status"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+func NewForgeDatabaseUserResource() resource.Resource {
+	return &ForgeDatabaseUserResource{}
+}
+
+func (r *ForgeDatabaseUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_database_user"
+}
+
+func (r *ForgeDatabaseUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge database user resource. This resource allows you to manage database users and the databases they can access.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the database user is created on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the database user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password of the database user. Forge cannot change the password of an existing user, so changing it recreates the user. Imported users adopt the configured password without being recreated.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// Imported users have no password in state; adopt the configured one.
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the password recreates the user unless it was imported.",
+						"Changing the password recreates the user unless it was imported.",
+					),
+				},
+			},
+			"databases": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+				MarkdownDescription: "The IDs of the databases the user can access. Changes are applied in place.",
+			},
+			"sync_databases": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to sync the server's databases before granting access, so databases created outside of Forge can be referenced. Default is false.",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeDatabaseUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeDatabaseUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeDatabaseUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+
+	var databases []int64
+	diags = plan.Databases.ElementsAs(ctx, &databases, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SyncDatabases.ValueBool() {
+		if err := r.client.SyncDatabase(ctx, serverID); err != nil {
+			resp.Diagnostics.AddError("Error syncing databases", err.Error())
+			return
+		}
+	}
+
+	// Build the CreateDatabaseUserRequest payload.
+	payload := forge_client.CreateDatabaseUserRequest{
+		Name:      plan.Name.ValueString(),
+		Password:  plan.Password.ValueString(),
+		Databases: databases,
+	}
+
+	user, err := r.client.CreateDatabaseUser(ctx, serverID, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating database user", err.Error())
+		return
+	}
+
+	plan.ID = types.Int64Value(user.ID)
+	resp.Diagnostics.Append(setForgeDatabaseUserState(ctx, &plan, user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeDatabaseUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeDatabaseUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetDatabaseUser(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorDatabaseUserNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading database user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setForgeDatabaseUserState(ctx, &state, user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeDatabaseUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ForgeDatabaseUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ForgeDatabaseUserResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+
+	// Update the database grants if they have changed.
+	if !plan.Databases.Equal(state.Databases) {
+		var databases []int64
+		diags = plan.Databases.ElementsAs(ctx, &databases, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.SyncDatabases.ValueBool() {
+			if err := r.client.SyncDatabase(ctx, serverID); err != nil {
+				resp.Diagnostics.AddError("Error syncing databases", err.Error())
+				return
+			}
+		}
+
+		user, err := r.client.UpdateDatabaseUser(ctx, serverID, int(plan.ID.ValueInt64()), forge_client.UpdateDatabaseUserRequest{
+			Databases: databases,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating database user", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(setForgeDatabaseUserState(ctx, &plan, user)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.Status = state.Status
+		plan.CreatedAt = state.CreatedAt
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeDatabaseUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeDatabaseUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDatabaseUser(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting database user", err.Error())
+		return
+	}
+}
+
+func (r *ForgeDatabaseUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:user_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:user_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	userID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid user_id", err.Error())
+		return
+	}
+
+	user, err := r.client.GetDatabaseUser(ctx, int(serverID), int(userID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading database user", err.Error())
+		return
+	}
+
+	var stateModel ForgeDatabaseUserResourceModel
+	stateModel.ID = types.Int64Value(user.ID)
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.Password = types.StringNull()
+	stateModel.SyncDatabases = types.BoolValue(false)
+	resp.Diagnostics.Append(setForgeDatabaseUserState(ctx, &stateModel, user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setForgeDatabaseUserState copies the API representation of a database user onto the model.
+func setForgeDatabaseUserState(ctx context.Context, model *ForgeDatabaseUserResourceModel, user *forge_client.DatabaseUser) diag.Diagnostics {
+	databases := user.Databases
+	if databases == nil {
+		databases = []int64{}
+	}
+	setVal, diags := types.SetValueFrom(ctx, types.Int64Type, databases)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.Name = types.StringValue(user.Name)
+	model.Databases = setVal
+	model.Status = types.StringValue(user.Status)
+	model.CreatedAt = types.StringValue(user.CreatedAt)
+	return diags
+}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeFirewallRuleResource{}
+var _ resource.ResourceWithImportState = &ForgeFirewallRuleResource{}
+
+// firewallPortPattern matches a single port ("6379") or a port range ("8000-8010").
+var firewallPortPattern = regexp.MustCompile(`^\d+(-\d+)?$`)
+
+// ForgeFirewallRuleResource implements a Terraform resource for a Forge firewall rule.
+type ForgeFirewallRuleResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeFirewallRuleResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	ServerID    types.Int64  `tfsdk:"server_id"`
+	Name        types.String `tfsdk:"name"`
+	Port        types.String `tfsdk:"port"`
+	IPAddresses types.Set    `tfsdk:"ip_addresses"`
+	Type        types.String `tfsdk:"type"`
+	Status      types.String `tfsdk:"status"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func NewForgeFirewallRuleResource() resource.Resource {
+	return &ForgeFirewallRuleResource{}
+}
+
+func (r *ForgeFirewallRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_firewall_rule"
+}
+
+func (r *ForgeFirewallRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge firewall rule resource. This resource allows you to manage firewall rules on Forge servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the firewall rule is applied to.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the firewall rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The port or port range the rule applies to, e.g. `6379` or `8000-8010`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_addresses": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The IP addresses the rule applies to. If omitted, the rule applies to any IP address.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("allow"),
+				MarkdownDescription: "The type of the rule. Valid values are `allow` and `deny`. Default is 'allow'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeFirewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeFirewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeFirewallRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !firewallPortPattern.MatchString(plan.Port.ValueString()) {
+		resp.Diagnostics.AddError("Invalid port", fmt.Sprintf("Expected a port (e.g. 6379) or a port range (e.g. 8000-8010), got: %q", plan.Port.ValueString()))
+		return
+	}
+
+	ruleType := plan.Type.ValueString()
+	if ruleType != "allow" && ruleType != "deny" {
+		resp.Diagnostics.AddError("Invalid type", fmt.Sprintf("Expected 'allow' or 'deny', got: %q", ruleType))
+		return
+	}
+
+	// Build the CreateFirewallRuleRequest payload.
+	payload := forge_client.CreateFirewallRuleRequest{
+		Name: plan.Name.ValueString(),
+		Port: forge_client.FirewallPort(plan.Port.ValueString()),
+		Type: ruleType,
+	}
+	if !plan.IPAddresses.IsNull() {
+		var ips []string
+		diags := plan.IPAddresses.ElementsAs(ctx, &ips, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(ips) > 0 {
+			joined := strings.Join(ips, ",")
+			payload.IpAddress = &joined
+		}
+	}
+
+	rule, err := r.client.CreateFirewallRule(ctx, int(plan.ServerID.ValueInt64()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating firewall rule", err.Error())
+		return
+	}
+
+	plan.ID = types.Int64Value(rule.ID)
+	plan.Status = types.StringValue(rule.Status)
+	plan.CreatedAt = types.StringValue(rule.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeFirewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeFirewallRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetFirewallRule(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorFirewallRuleNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading firewall rule", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(rule.Name)
+	state.Port = types.StringValue(string(rule.Port))
+	state.Type = types.StringValue(rule.Type)
+	state.Status = types.StringValue(rule.Status)
+	state.CreatedAt = types.StringValue(rule.CreatedAt)
+	// Keep an explicitly empty set in state when the API reports no IP restriction.
+	if ips := splitFirewallIPAddresses(rule.IpAddress); len(ips) > 0 || !state.IPAddresses.IsNull() {
+		setVal, diags := types.SetValueFrom(ctx, types.StringType, ips)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.IPAddresses = setVal
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeFirewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No update API exists for firewall rules; every attribute forces a replacement.
+	var plan ForgeFirewallRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeFirewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeFirewallRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallRule(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting firewall rule", err.Error())
+		return
+	}
+}
+
+func (r *ForgeFirewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:rule_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:rule_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	ruleID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid rule_id", err.Error())
+		return
+	}
+
+	rule, err := r.client.GetFirewallRule(ctx, int(serverID), int(ruleID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading firewall rule", err.Error())
+		return
+	}
+
+	var stateModel ForgeFirewallRuleResourceModel
+	stateModel.ID = types.Int64Value(rule.ID)
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.Name = types.StringValue(rule.Name)
+	stateModel.Port = types.StringValue(string(rule.Port))
+	stateModel.Type = types.StringValue(rule.Type)
+	stateModel.Status = types.StringValue(rule.Status)
+	stateModel.CreatedAt = types.StringValue(rule.CreatedAt)
+	stateModel.IPAddresses = types.SetNull(types.StringType)
+	if ips := splitFirewallIPAddresses(rule.IpAddress); len(ips) > 0 {
+		setVal, diags := types.SetValueFrom(ctx, types.StringType, ips)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateModel.IPAddresses = setVal
+	}
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// splitFirewallIPAddresses splits the comma separated ip_address value returned by Forge.
+func splitFirewallIPAddresses(ipAddress *string) []string {
+	ips := []string{}
+	if ipAddress == nil {
+		return ips
+	}
+	for _, ip := range strings.Split(*ipAddress, ",") {
+		if ip = strings.TrimSpace(ip); ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeMonitorResource{}
+var _ resource.ResourceWithImportState = &ForgeMonitorResource{}
+
+// ForgeMonitorResource implements a Terraform resource for a Forge server monitor.
+type ForgeMonitorResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeMonitorResourceModel struct {
+	ID             types.Int64   `tfsdk:"id"`
+	ServerID       types.Int64   `tfsdk:"server_id"`
+	Type           types.String  `tfsdk:"type"`
+	Operator       types.String  `tfsdk:"operator"`
+	Threshold      types.Float64 `tfsdk:"threshold"`
+	Minutes        types.Int64   `tfsdk:"minutes"`
+	Notify         types.String  `tfsdk:"notify"`
+	Status         types.String  `tfsdk:"status"`
+	State          types.String  `tfsdk:"state"`
+	StateChangedAt types.String  `tfsdk:"state_changed_at"`
+}
+
+func NewForgeMonitorResource() resource.Resource {
+	return &ForgeMonitorResource{}
+}
+
+func (r *ForgeMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_monitor"
+}
+
+func (r *ForgeMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge monitor resource. This resource allows you to manage disk, CPU and memory monitors on Forge servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server to monitor.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The metric to monitor. Valid values are `disk`, `cpu_load` and `used_memory`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operator": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("gte"),
+				MarkdownDescription: "The comparison operator. Valid values are `gte` and `lte`. Default is 'gte'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"threshold": schema.Float64Attribute{
+				Required:            true,
+				MarkdownDescription: "The threshold that triggers the alert, as a percentage for `disk` and `used_memory` or as the load average for `cpu_load`.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"minutes": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				MarkdownDescription: "The number of minutes the threshold must be exceeded before alerting. Default is 5.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"notify": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email address to notify when the monitor changes state. Imported monitors adopt the configured address without being recreated.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// The API never returns the address, so imported monitors have none in state.
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the notification address recreates the monitor unless it was imported.",
+						"Changing the notification address recreates the monitor unless it was imported.",
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The current alert state of the monitor, e.g. `OK` or `ALERT`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state_changed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time the monitor last changed state.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeMonitorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch plan.Type.ValueString() {
+	case "disk", "cpu_load", "used_memory":
+	default:
+		resp.Diagnostics.AddError("Invalid type", fmt.Sprintf("Expected 'disk', 'cpu_load' or 'used_memory', got: %q", plan.Type.ValueString()))
+		return
+	}
+
+	operator := plan.Operator.ValueString()
+	if operator != "gte" && operator != "lte" {
+		resp.Diagnostics.AddError("Invalid operator", fmt.Sprintf("Expected 'gte' or 'lte', got: %q", operator))
+		return
+	}
+
+	// Build the CreateMonitorRequest payload.
+	payload := forge_client.CreateMonitorRequest{
+		Type:      plan.Type.ValueString(),
+		Operator:  operator,
+		Threshold: plan.Threshold.ValueFloat64(),
+		Minutes:   int(plan.Minutes.ValueInt64()),
+		Notify:    plan.Notify.ValueString(),
+	}
+
+	monitor, err := r.client.CreateMonitor(ctx, int(plan.ServerID.ValueInt64()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating monitor", err.Error())
+		return
+	}
+
+	setForgeMonitorState(&plan, monitor)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeMonitorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, err := r.client.GetMonitor(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorMonitorNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading monitor", err.Error())
+		return
+	}
+
+	setForgeMonitorState(&state, monitor)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No update API exists for monitors; every attribute forces a replacement.
+	var plan ForgeMonitorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeMonitorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMonitor(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting monitor", err.Error())
+		return
+	}
+}
+
+func (r *ForgeMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:monitor_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:monitor_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	monitorID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid monitor_id", err.Error())
+		return
+	}
+
+	monitor, err := r.client.GetMonitor(ctx, int(serverID), int(monitorID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading monitor", err.Error())
+		return
+	}
+
+	var stateModel ForgeMonitorResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	// The API does not return the notification address; it is adopted from the configuration on the next apply.
+	stateModel.Notify = types.StringNull()
+	setForgeMonitorState(&stateModel, monitor)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setForgeMonitorState copies the attributes returned by the API onto the model.
+func setForgeMonitorState(model *ForgeMonitorResourceModel, monitor *forge_client.Monitor) {
+	model.ID = types.Int64Value(monitor.ID)
+	model.Type = types.StringValue(monitor.Type)
+	model.Operator = types.StringValue(monitor.Operator)
+	model.Threshold = types.Float64Value(monitor.Threshold)
+	model.Minutes = types.Int64Value(int64(monitor.Minutes))
+	model.Status = types.StringValue(monitor.Status)
+	model.State = types.StringValue(monitor.State)
+	model.StateChangedAt = types.StringValue(monitor.StateChangedAt)
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ForgeNginxTemplateDataSource{}
+
+func NewForgeNginxTemplateDataSource() datasource.DataSource {
+	return &ForgeNginxTemplateDataSource{}
+}
+
+type ForgeNginxTemplateDataSource struct {
+	client *forge_client.Client
+}
+
+type ForgeNginxTemplateDataSourceModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	ServerID types.Int64  `tfsdk:"server_id"`
+	Name     types.String `tfsdk:"name"`
+	Content  types.String `tfsdk:"content"`
+}
+
+func (d *ForgeNginxTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_nginx_template"
+}
+
+func (d *ForgeNginxTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for looking up a Forge Nginx template on a server by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the template. Can be passed to the `nginx_template` attribute of `laravel_forge_site`.",
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the template belongs to.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the template.",
+			},
+			"content": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The content of the template.",
+			},
+		},
+	}
+}
+
+func (d *ForgeNginxTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	d.client = providerConfig.Forge
+}
+
+func (d *ForgeNginxTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ForgeNginxTemplateDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templates, err := d.client.ListNginxTemplates(ctx, int(state.ServerID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading nginx templates", err.Error())
+		return
+	}
+
+	var template *forge_client.NginxTemplate
+	for i := range templates {
+		if templates[i].Name == state.Name.ValueString() {
+			template = &templates[i]
+			break
+		}
+	}
+	if template == nil {
+		resp.Diagnostics.AddError(
+			"Nginx template not found",
+			fmt.Sprintf("No nginx template named %q exists on server %d.", state.Name.ValueString(), state.ServerID.ValueInt64()),
+		)
+		return
+	}
+
+	state.ID = types.Int64Value(template.ID)
+	state.Content = types.StringValue(template.Content)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
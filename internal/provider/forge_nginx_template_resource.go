@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeNginxTemplateResource{}
+var _ resource.ResourceWithImportState = &ForgeNginxTemplateResource{}
+
+// ForgeNginxTemplateResource implements a Terraform resource for a Forge Nginx template.
+type ForgeNginxTemplateResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeNginxTemplateResourceModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	ServerID types.Int64  `tfsdk:"server_id"`
+	Name     types.String `tfsdk:"name"`
+	Content  types.String `tfsdk:"content"`
+}
+
+func NewForgeNginxTemplateResource() resource.Resource {
+	return &ForgeNginxTemplateResource{}
+}
+
+func (r *ForgeNginxTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_nginx_template"
+}
+
+func (r *ForgeNginxTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge Nginx template resource. This resource allows you to manage Nginx templates on a server. The template ID can be passed to the `nginx_template` attribute of `laravel_forge_site`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the template belongs to.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the template.",
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The content of the template. Forge replaces variables such as `{{DOMAINS}}`, `{{DIRECTORY}}` and `{{PATH}}` when the template is applied to a site.",
+			},
+		},
+	}
+}
+
+func (r *ForgeNginxTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeNginxTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeNginxTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template, err := r.client.CreateNginxTemplate(ctx, int(plan.ServerID.ValueInt64()), plan.Name.ValueString(), plan.Content.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating nginx template", err.Error())
+		return
+	}
+
+	plan.ID = types.Int64Value(template.ID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeNginxTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeNginxTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template, err := r.client.GetNginxTemplate(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorNginxTemplateNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading nginx template", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(template.Name)
+	state.Content = types.StringValue(template.Content)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeNginxTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ForgeNginxTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateNginxTemplate(ctx, int(plan.ServerID.ValueInt64()), int(plan.ID.ValueInt64()), plan.Name.ValueString(), plan.Content.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating nginx template", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeNginxTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeNginxTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNginxTemplate(ctx, int(state.ServerID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting nginx template", err.Error())
+		return
+	}
+}
+
+func (r *ForgeNginxTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:template_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:template_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	templateID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid template_id", err.Error())
+		return
+	}
+
+	template, err := r.client.GetNginxTemplate(ctx, int(serverID), int(templateID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading nginx template", err.Error())
+		return
+	}
+
+	var stateModel ForgeNginxTemplateResourceModel
+	stateModel.ID = types.Int64Value(template.ID)
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.Name = types.StringValue(template.Name)
+	stateModel.Content = types.StringValue(template.Content)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
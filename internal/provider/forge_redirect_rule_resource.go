@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeRedirectRuleResource{}
+var _ resource.ResourceWithImportState = &ForgeRedirectRuleResource{}
+var _ resource.ResourceWithModifyPlan = &ForgeRedirectRuleResource{}
+
+// ForgeRedirectRuleResource implements a Terraform resource for a Forge site redirect rule.
+type ForgeRedirectRuleResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeRedirectRuleResourceModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	ServerID         types.Int64  `tfsdk:"server_id"`
+	SiteID           types.Int64  `tfsdk:"site_id"`
+	From             types.String `tfsdk:"from"`
+	To               types.String `tfsdk:"to"`
+	Type             types.String `tfsdk:"type"`
+	Authoritative    types.Bool   `tfsdk:"authoritative"`
+	ManagedRuleIDs   types.Set    `tfsdk:"managed_rule_ids"`
+	UnmanagedRuleIDs types.Set    `tfsdk:"unmanaged_rule_ids"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+func NewForgeRedirectRuleResource() resource.Resource {
+	return &ForgeRedirectRuleResource{}
+}
+
+func (r *ForgeRedirectRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_redirect_rule"
+}
+
+func (r *ForgeRedirectRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge redirect rule resource. This resource allows you to manage redirect rules on Forge sites.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site the redirect rule belongs to.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"from": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The path to redirect from, e.g. `/old-page`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The path or URL to redirect to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("redirect"),
+				MarkdownDescription: "The type of the redirect. Valid values are `redirect` (302) and `permanent` (301). Default is 'redirect'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "If true, every other redirect rule on the site that is not listed in `managed_rule_ids` is deleted. " +
+					"Rules added outside Terraform later show up in `unmanaged_rule_ids` and are deleted on the next apply. Set it on a single rule per site.",
+			},
+			"managed_rule_ids": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				MarkdownDescription: "The IDs of the other redirect rules on the site that Terraform manages, which `authoritative` keeps, e.g. `[for rule in laravel_forge_redirect_rule.other : rule.id]`.",
+			},
+			"unmanaged_rule_ids": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the redirect rules on the site that `authoritative` deletes on the next apply. Always empty after an apply.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeRedirectRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeRedirectRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ForgeRedirectRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Authoritative rules leave no unmanaged rules behind, so any found on refresh show up as a change.
+	unmanaged := types.SetNull(types.Int64Type)
+	if plan.Authoritative.ValueBool() {
+		unmanaged = types.SetValueMust(types.Int64Type, []attr.Value{})
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_rule_ids"), unmanaged)...)
+}
+
+func (r *ForgeRedirectRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeRedirectRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleType := plan.Type.ValueString()
+	if ruleType != "redirect" && ruleType != "permanent" {
+		resp.Diagnostics.AddError("Invalid type", fmt.Sprintf("Expected 'redirect' or 'permanent', got: %q", ruleType))
+		return
+	}
+
+	// Build the CreateRedirectRuleRequest payload.
+	payload := forge_client.CreateRedirectRuleRequest{
+		From: plan.From.ValueString(),
+		To:   plan.To.ValueString(),
+		Type: ruleType,
+	}
+
+	rule, err := r.client.CreateRedirectRule(ctx, int(plan.ServerID.ValueInt64()), int(plan.SiteID.ValueInt64()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating redirect rule", err.Error())
+		return
+	}
+
+	plan.ID = types.Int64Value(rule.ID)
+	plan.CreatedAt = types.StringValue(rule.CreatedAt)
+
+	// Save the state before pruning so a failed cleanup doesn't orphan the new rule.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Authoritative.ValueBool() {
+		resp.Diagnostics.Append(r.pruneRedirectRules(ctx, plan)...)
+	}
+}
+
+func (r *ForgeRedirectRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeRedirectRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetRedirectRule(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorRedirectRuleNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading redirect rule", err.Error())
+		return
+	}
+
+	state.From = types.StringValue(rule.From)
+	state.To = types.StringValue(rule.To)
+	state.Type = types.StringValue(rule.Type)
+	state.CreatedAt = types.StringValue(rule.CreatedAt)
+
+	state.UnmanagedRuleIDs = types.SetNull(types.Int64Type)
+	if state.Authoritative.ValueBool() {
+		unmanaged, diags := r.listUnmanagedRedirectRules(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ids := make([]attr.Value, 0, len(unmanaged))
+		for _, rule := range unmanaged {
+			ids = append(ids, types.Int64Value(rule.ID))
+		}
+		state.UnmanagedRuleIDs = types.SetValueMust(types.Int64Type, ids)
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeRedirectRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No update API exists for redirect rules; only `authoritative` and `managed_rule_ids` can change in place.
+	var plan ForgeRedirectRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Authoritative.ValueBool() {
+		resp.Diagnostics.Append(r.pruneRedirectRules(ctx, plan)...)
+	}
+}
+
+func (r *ForgeRedirectRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeRedirectRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRedirectRule(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting redirect rule", err.Error())
+		return
+	}
+}
+
+func (r *ForgeRedirectRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in the format "server_id:site_id:rule_id"
+	parts := splitCompositeID(req.ID, 3)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id:rule_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+	ruleID, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid rule_id", err.Error())
+		return
+	}
+
+	rule, err := r.client.GetRedirectRule(ctx, int(serverID), int(siteID), int(ruleID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading redirect rule", err.Error())
+		return
+	}
+
+	var stateModel ForgeRedirectRuleResourceModel
+	stateModel.ID = types.Int64Value(rule.ID)
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	stateModel.From = types.StringValue(rule.From)
+	stateModel.To = types.StringValue(rule.To)
+	stateModel.Type = types.StringValue(rule.Type)
+	stateModel.Authoritative = types.BoolValue(false)
+	stateModel.ManagedRuleIDs = types.SetNull(types.Int64Type)
+	stateModel.UnmanagedRuleIDs = types.SetNull(types.Int64Type)
+	stateModel.CreatedAt = types.StringValue(rule.CreatedAt)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// listUnmanagedRedirectRules returns every redirect rule on the site other than the model itself and the managed rules it lists.
+func (r *ForgeRedirectRuleResource) listUnmanagedRedirectRules(ctx context.Context, model ForgeRedirectRuleResourceModel) ([]forge_client.RedirectRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var managedIDs []int64
+	if !model.ManagedRuleIDs.IsNull() && !model.ManagedRuleIDs.IsUnknown() {
+		diags.Append(model.ManagedRuleIDs.ElementsAs(ctx, &managedIDs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+	keep := map[int64]bool{model.ID.ValueInt64(): true}
+	for _, id := range managedIDs {
+		keep[id] = true
+	}
+
+	rules, err := r.client.ListRedirectRulesWithoutCache(ctx, int(model.ServerID.ValueInt64()), int(model.SiteID.ValueInt64()))
+	if err != nil {
+		diags.AddError("Error listing redirect rules", err.Error())
+		return nil, diags
+	}
+
+	var unmanaged []forge_client.RedirectRule
+	for _, rule := range rules {
+		if !keep[rule.ID] {
+			unmanaged = append(unmanaged, rule)
+		}
+	}
+	return unmanaged, diags
+}
+
+// pruneRedirectRules deletes every redirect rule on the site other than the model itself and the managed rules it lists.
+func (r *ForgeRedirectRuleResource) pruneRedirectRules(ctx context.Context, model ForgeRedirectRuleResourceModel) diag.Diagnostics {
+	serverID := int(model.ServerID.ValueInt64())
+	siteID := int(model.SiteID.ValueInt64())
+
+	rules, diags := r.listUnmanagedRedirectRules(ctx, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, rule := range rules {
+		err := r.client.DeleteRedirectRule(ctx, serverID, siteID, int(rule.ID))
+		if err != nil {
+			if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+				continue
+			}
+			diags.AddError("Error deleting unmanaged redirect rule", fmt.Sprintf("rule %d (%s -> %s): %s", rule.ID, rule.From, rule.To, err.Error()))
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,459 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSecurityRuleResource{}
+var _ resource.ResourceWithImportState = &ForgeSecurityRuleResource{}
+var _ resource.ResourceWithModifyPlan = &ForgeSecurityRuleResource{}
+
+// securityRuleCredentialType is the object type of a single entry in the `credentials` set.
+var securityRuleCredentialType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"username": types.StringType,
+		"password": types.StringType,
+	},
+}
+
+// ForgeSecurityRuleResource implements a Terraform resource for a Forge site security rule.
+type ForgeSecurityRuleResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSecurityRuleResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	ServerID    types.Int64  `tfsdk:"server_id"`
+	SiteID      types.Int64  `tfsdk:"site_id"`
+	Name        types.String `tfsdk:"name"`
+	Path        types.String `tfsdk:"path"`
+	Credentials types.Set    `tfsdk:"credentials"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+type ForgeSecurityRuleCredentialModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+func NewForgeSecurityRuleResource() resource.Resource {
+	return &ForgeSecurityRuleResource{}
+}
+
+func (r *ForgeSecurityRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_security_rule"
+}
+
+func (r *ForgeSecurityRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge security rule resource. This resource allows you to protect Forge sites, or paths within them, with HTTP basic authentication. " +
+			"Forge cannot update a security rule, so any change creates a new rule before the old one is deleted, keeping the site protected throughout.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site to protect.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the security rule.",
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path to protect, e.g. `/admin`. If omitted, the whole site is protected.",
+			},
+			"credentials": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The credentials that are allowed to access the protected path. Changing the credentials recreates the rule. Imported rules adopt the configured passwords without being recreated.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The username.",
+						},
+						"password": schema.StringAttribute{
+							Required:            true,
+							Sensitive:           true,
+							MarkdownDescription: "The password.",
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeSecurityRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSecurityRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ForgeSecurityRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A recreated rule gets a new ID, which is only known after the apply.
+	if securityRuleNeedsRecreate(ctx, plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.Int64Unknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("created_at"), types.StringUnknown())...)
+	}
+}
+
+func (r *ForgeSecurityRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSecurityRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := r.createSecurityRule(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.Int64Value(rule.ID)
+	plan.CreatedAt = types.StringValue(rule.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSecurityRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSecurityRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetSecurityRule(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorSecurityRuleNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading security rule", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(rule.Name)
+	state.CreatedAt = types.StringValue(rule.CreatedAt)
+	if rule.Path != nil && *rule.Path != "" {
+		state.Path = types.StringValue(*rule.Path)
+	} else {
+		state.Path = types.StringNull()
+	}
+
+	credentials, diags := securityRuleCredentialsFromAPI(ctx, rule.Credentials, state.Credentials)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Credentials = credentials
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSecurityRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ForgeSecurityRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported credentials only adopt the configured passwords.
+	if !securityRuleNeedsRecreate(ctx, plan, state) {
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// No update API exists for security rules. The replacement is created before the old rule is deleted,
+	// so the site is never left unprotected.
+	rule, diags := r.createSecurityRule(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.Int64Value(rule.ID)
+	plan.CreatedAt = types.StringValue(rule.CreatedAt)
+
+	// Save the state before deleting the old rule so a failed cleanup doesn't orphan the new one.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSecurityRule(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting replaced security rule", fmt.Sprintf("rule %d: %s", state.ID.ValueInt64(), err.Error()))
+		return
+	}
+}
+
+func (r *ForgeSecurityRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeSecurityRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSecurityRule(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting security rule", err.Error())
+		return
+	}
+}
+
+func (r *ForgeSecurityRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in the format "server_id:site_id:rule_id"
+	parts := splitCompositeID(req.ID, 3)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id:rule_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+	ruleID, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid rule_id", err.Error())
+		return
+	}
+
+	rule, err := r.client.GetSecurityRule(ctx, int(serverID), int(siteID), int(ruleID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading security rule", err.Error())
+		return
+	}
+
+	var stateModel ForgeSecurityRuleResourceModel
+	stateModel.ID = types.Int64Value(rule.ID)
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	stateModel.Name = types.StringValue(rule.Name)
+	stateModel.CreatedAt = types.StringValue(rule.CreatedAt)
+	stateModel.Path = types.StringNull()
+	if rule.Path != nil && *rule.Path != "" {
+		stateModel.Path = types.StringValue(*rule.Path)
+	}
+
+	// Passwords are never returned by the API; they are adopted from the configuration on the next apply.
+	credentials, diags := securityRuleCredentialsFromAPI(ctx, rule.Credentials, types.SetNull(securityRuleCredentialType))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateModel.Credentials = credentials
+
+	diags = resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// createSecurityRule creates a security rule from the planned attributes.
+func (r *ForgeSecurityRuleResource) createSecurityRule(ctx context.Context, plan ForgeSecurityRuleResourceModel) (*forge_client.SecurityRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var credentials []ForgeSecurityRuleCredentialModel
+	diags.Append(plan.Credentials.ElementsAs(ctx, &credentials, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(credentials) == 0 {
+		diags.AddError("Credentials required", "At least one set of credentials must be provided.")
+		return nil, diags
+	}
+
+	// Build the CreateSecurityRuleRequest payload.
+	payload := forge_client.CreateSecurityRuleRequest{
+		Name: plan.Name.ValueString(),
+	}
+	if !plan.Path.IsNull() && plan.Path.ValueString() != "" {
+		rulePath := plan.Path.ValueString()
+		payload.Path = &rulePath
+	}
+	for _, c := range credentials {
+		payload.Credentials = append(payload.Credentials, forge_client.SecurityRuleCredentialRequest{
+			Username: c.Username.ValueString(),
+			Password: c.Password.ValueString(),
+		})
+	}
+
+	rule, err := r.client.CreateSecurityRule(ctx, int(plan.ServerID.ValueInt64()), int(plan.SiteID.ValueInt64()), payload)
+	if err != nil {
+		diags.AddError("Error creating security rule", err.Error())
+		return nil, diags
+	}
+	return rule, diags
+}
+
+// securityRuleNeedsRecreate reports whether the planned rule differs from the one in state in a way
+// that requires a new rule, as opposed to imported credentials adopting the configured passwords.
+func securityRuleNeedsRecreate(ctx context.Context, plan, state ForgeSecurityRuleResourceModel) bool {
+	if !plan.Name.Equal(state.Name) || !plan.Path.Equal(state.Path) {
+		return true
+	}
+	return !plan.Credentials.Equal(state.Credentials) && !securityRuleCredentialsImported(ctx, state.Credentials, plan.Credentials)
+}
+
+// securityRuleCredentialsFromAPI builds the `credentials` set from the usernames returned by the API,
+// keeping the passwords already known in the prior state.
+func securityRuleCredentialsFromAPI(ctx context.Context, apiCredentials []forge_client.SecurityCredential, prior types.Set) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	passwords := map[string]types.String{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorCredentials []ForgeSecurityRuleCredentialModel
+		diags.Append(prior.ElementsAs(ctx, &priorCredentials, false)...)
+		if diags.HasError() {
+			return prior, diags
+		}
+		for _, c := range priorCredentials {
+			passwords[c.Username.ValueString()] = c.Password
+		}
+	}
+
+	credentials := make([]ForgeSecurityRuleCredentialModel, 0, len(apiCredentials))
+	for _, c := range apiCredentials {
+		password, ok := passwords[c.Username]
+		if !ok {
+			password = types.StringNull()
+		}
+		credentials = append(credentials, ForgeSecurityRuleCredentialModel{
+			Username: types.StringValue(c.Username),
+			Password: password,
+		})
+	}
+
+	setVal, d := types.SetValueFrom(ctx, securityRuleCredentialType, credentials)
+	diags.Append(d...)
+	return setVal, diags
+}
+
+// securityRuleCredentialsImported reports whether the state holds imported credentials (no passwords)
+// for exactly the usernames in the plan, in which case the configured passwords are adopted in place.
+func securityRuleCredentialsImported(ctx context.Context, state, plan types.Set) bool {
+	if state.IsNull() || state.IsUnknown() || plan.IsNull() || plan.IsUnknown() {
+		return false
+	}
+
+	var stateCredentials, planCredentials []ForgeSecurityRuleCredentialModel
+	if diags := state.ElementsAs(ctx, &stateCredentials, false); diags.HasError() {
+		return false
+	}
+	if diags := plan.ElementsAs(ctx, &planCredentials, false); diags.HasError() {
+		return false
+	}
+
+	var stateUsernames, planUsernames []string
+	for _, c := range stateCredentials {
+		if !c.Password.IsNull() {
+			return false
+		}
+		stateUsernames = append(stateUsernames, c.Username.ValueString())
+	}
+	for _, c := range planCredentials {
+		planUsernames = append(planUsernames, c.Username.ValueString())
+	}
+	if len(stateUsernames) != len(planUsernames) {
+		return false
+	}
+	sort.Strings(stateUsernames)
+	sort.Strings(planUsernames)
+	for i := range stateUsernames {
+		if stateUsernames[i] != planUsernames[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeServerBlackfireResource{}
+var _ resource.ResourceWithImportState = &ForgeServerBlackfireResource{}
+
+// ForgeServerBlackfireResource implements a Terraform resource for the Blackfire integration of a Forge server.
+type ForgeServerBlackfireResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeServerBlackfireResourceModel struct {
+	ServerID          types.Int64  `tfsdk:"server_id"`
+	BlackfireServerID types.String `tfsdk:"blackfire_server_id"`
+	ServerToken       types.String `tfsdk:"server_token"`
+	Status            types.String `tfsdk:"status"`
+}
+
+func NewForgeServerBlackfireResource() resource.Resource {
+	return &ForgeServerBlackfireResource{}
+}
+
+func (r *ForgeServerBlackfireResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_server_blackfire"
+}
+
+func (r *ForgeServerBlackfireResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The API never returns the credentials, so imported installations have none in state.
+	credentialsImported := func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = !req.StateValue.IsNull()
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge server Blackfire resource. This resource installs the Blackfire profiling agent on a Forge server and removes it when destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server to install Blackfire on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"blackfire_server_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The server ID of the Blackfire environment. Imported installations adopt the configured value without being reinstalled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						credentialsImported,
+						"Changing the Blackfire server ID reinstalls Blackfire unless it was imported.",
+						"Changing the Blackfire server ID reinstalls Blackfire unless it was imported.",
+					),
+				},
+			},
+			"server_token": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The server token of the Blackfire environment. Imported installations adopt the configured value without being reinstalled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						credentialsImported,
+						"Changing the Blackfire server token reinstalls Blackfire unless it was imported.",
+						"Changing the Blackfire server token reinstalls Blackfire unless it was imported.",
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Blackfire installation status reported by Forge, e.g. `installing` or `installed`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeServerBlackfireResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeServerBlackfireResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeServerBlackfireResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+
+	err := r.client.InstallBlackfire(ctx, serverID, plan.BlackfireServerID.ValueString(), plan.ServerToken.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error installing blackfire", err.Error())
+		return
+	}
+
+	server, err := r.client.GetServerWithoutCache(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading server", err.Error())
+		return
+	}
+
+	plan.Status = types.StringValue("installing")
+	if server.BlackfireStatus != nil {
+		plan.Status = types.StringValue(*server.BlackfireStatus)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerBlackfireResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeServerBlackfireResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.client.GetServer(ctx, int(state.ServerID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading server", err.Error())
+		return
+	}
+	// Blackfire removed outside Terraform is installed again on the next apply.
+	if server.BlackfireStatus == nil || *server.BlackfireStatus == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Status = types.StringValue(*server.BlackfireStatus)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerBlackfireResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No update API exists for Blackfire; changing the credentials reinstalls it, except after an import.
+	var plan ForgeServerBlackfireResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerBlackfireResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeServerBlackfireResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveBlackfire(ctx, int(state.ServerID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error removing blackfire", err.Error())
+		return
+	}
+}
+
+func (r *ForgeServerBlackfireResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id"
+	serverID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id")
+		return
+	}
+
+	server, err := r.client.GetServer(ctx, int(serverID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading server", err.Error())
+		return
+	}
+	if server.BlackfireStatus == nil || *server.BlackfireStatus == "" {
+		resp.Diagnostics.AddError("Blackfire not installed", fmt.Sprintf("Blackfire is not installed on server %d.", serverID))
+		return
+	}
+
+	var stateModel ForgeServerBlackfireResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	// The API does not return the credentials; they are adopted from the configuration on the next apply.
+	stateModel.BlackfireServerID = types.StringNull()
+	stateModel.ServerToken = types.StringNull()
+	stateModel.Status = types.StringValue(*server.BlackfireStatus)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeServerPapertrailResource{}
+var _ resource.ResourceWithImportState = &ForgeServerPapertrailResource{}
+
+// ForgeServerPapertrailResource implements a Terraform resource for the Papertrail integration of a Forge server.
+type ForgeServerPapertrailResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeServerPapertrailResourceModel struct {
+	ServerID types.Int64  `tfsdk:"server_id"`
+	Host     types.String `tfsdk:"host"`
+	Status   types.String `tfsdk:"status"`
+}
+
+func NewForgeServerPapertrailResource() resource.Resource {
+	return &ForgeServerPapertrailResource{}
+}
+
+func (r *ForgeServerPapertrailResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_server_papertrail"
+}
+
+func (r *ForgeServerPapertrailResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge server Papertrail resource. This resource ships the logs of a Forge server to Papertrail and removes the integration when destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server to install Papertrail on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The Papertrail log destination, e.g. `logs.papertrailapp.com:12345`. Imported installations adopt the configured host without being reinstalled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// The API never returns the host, so imported installations have none in state.
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the host reinstalls Papertrail unless it was imported.",
+						"Changing the host reinstalls Papertrail unless it was imported.",
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Papertrail installation status reported by Forge, e.g. `installing` or `installed`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeServerPapertrailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeServerPapertrailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeServerPapertrailResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+
+	err := r.client.InstallPapertrail(ctx, serverID, plan.Host.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error installing papertrail", err.Error())
+		return
+	}
+
+	server, err := r.client.GetServerWithoutCache(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading server", err.Error())
+		return
+	}
+
+	plan.Status = types.StringValue("installing")
+	if server.PapertrailStatus != nil {
+		plan.Status = types.StringValue(*server.PapertrailStatus)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerPapertrailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeServerPapertrailResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.client.GetServer(ctx, int(state.ServerID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading server", err.Error())
+		return
+	}
+	// Papertrail removed outside Terraform is installed again on the next apply.
+	if server.PapertrailStatus == nil || *server.PapertrailStatus == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Status = types.StringValue(*server.PapertrailStatus)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerPapertrailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No update API exists for Papertrail; changing the host reinstalls it, except after an import.
+	var plan ForgeServerPapertrailResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerPapertrailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeServerPapertrailResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemovePapertrail(ctx, int(state.ServerID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error removing papertrail", err.Error())
+		return
+	}
+}
+
+func (r *ForgeServerPapertrailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id"
+	serverID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id")
+		return
+	}
+
+	server, err := r.client.GetServer(ctx, int(serverID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading server", err.Error())
+		return
+	}
+	if server.PapertrailStatus == nil || *server.PapertrailStatus == "" {
+		resp.Diagnostics.AddError("Papertrail not installed", fmt.Sprintf("Papertrail is not installed on server %d.", serverID))
+		return
+	}
+
+	var stateModel ForgeServerPapertrailResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	// The API does not return the host; it is adopted from the configuration on the next apply.
+	stateModel.Host = types.StringNull()
+	stateModel.Status = types.StringValue(*server.PapertrailStatus)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeServerPHPResource{}
+var _ resource.ResourceWithImportState = &ForgeServerPHPResource{}
+
+// ForgeServerPHPResource implements a Terraform resource for a PHP version installed on a Forge server.
+type ForgeServerPHPResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeServerPHPResourceModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	ServerID             types.Int64  `tfsdk:"server_id"`
+	Version              types.String `tfsdk:"version"`
+	OPCache              types.Bool   `tfsdk:"opcache"`
+	UpgradePatchTriggers types.Map    `tfsdk:"upgrade_patch_triggers"`
+	DisplayableVersion   types.String `tfsdk:"displayable_version"`
+	BinaryName           types.String `tfsdk:"binary_name"`
+	Status               types.String `tfsdk:"status"`
+	UsedAsDefault        types.Bool   `tfsdk:"used_as_default"`
+	UsedOnCLI            types.Bool   `tfsdk:"used_on_cli"`
+}
+
+func NewForgeServerPHPResource() resource.Resource {
+	return &ForgeServerPHPResource{}
+}
+
+func (r *ForgeServerPHPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_server_php"
+}
+
+func (r *ForgeServerPHPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge server PHP resource. This resource installs a PHP version on a Forge server and waits until the installation has finished, " +
+			"so sites using the version can depend on it. Forge cannot uninstall PHP versions; destroying the resource only stops managing it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server to install PHP on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The PHP version to install, e.g. `php83`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"opcache": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Whether OPCache is enabled. OPCache is a server-wide setting, so manage it from a single resource per server. " +
+					"Leave unset to keep the current setting.",
+			},
+			"upgrade_patch_triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary map of values that, when changed, upgrades the PHP version to its latest patch release.",
+			},
+			"displayable_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The human readable version, e.g. `PHP 8.3`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"binary_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the PHP binary, e.g. `php8.3`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"used_as_default": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the version is the default for new sites on the server.",
+			},
+			"used_on_cli": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the version is used by the `php` command on the server.",
+			},
+		},
+	}
+}
+
+func (r *ForgeServerPHPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeServerPHPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeServerPHPResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+	version := plan.Version.ValueString()
+
+	// Servers are provisioned with a PHP version already installed; adopt it instead of failing.
+	_, err := r.client.GetPHPVersion(ctx, serverID, version)
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorPHPVersionNotFound); !ok {
+			resp.Diagnostics.AddError("Error reading php version", err.Error())
+			return
+		}
+		if err := r.client.InstallPHPVersion(ctx, serverID, version); err != nil {
+			resp.Diagnostics.AddError("Error installing php version", err.Error())
+			return
+		}
+	}
+
+	php, err := r.client.WaitForPHPVersionToBeInstalled(ctx, serverID, version)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for php version to be installed", err.Error())
+		return
+	}
+
+	if !plan.OPCache.IsNull() {
+		if err := r.setOPCache(ctx, serverID, plan.OPCache.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Error configuring opcache", err.Error())
+			return
+		}
+	}
+
+	setForgeServerPHPState(&plan, php)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerPHPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeServerPHPResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(state.ServerID.ValueInt64())
+
+	php, err := r.client.GetPHPVersion(ctx, serverID, state.Version.ValueString())
+	if err != nil {
+		if _, ok := err.(*forge_client.ErrorPHPVersionNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading php version", err.Error())
+		return
+	}
+
+	if !state.OPCache.IsNull() {
+		server, err := r.client.GetServer(ctx, serverID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading server", err.Error())
+			return
+		}
+		state.OPCache = types.BoolValue(opcacheEnabled(server))
+	}
+
+	setForgeServerPHPState(&state, php)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerPHPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ForgeServerPHPResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+	version := plan.Version.ValueString()
+
+	if !plan.OPCache.IsNull() && !plan.OPCache.Equal(state.OPCache) {
+		if err := r.setOPCache(ctx, serverID, plan.OPCache.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Error configuring opcache", err.Error())
+			return
+		}
+	}
+
+	if !plan.UpgradePatchTriggers.Equal(state.UpgradePatchTriggers) {
+		if err := r.client.UpgradePHPPatchVersion(ctx, serverID, version); err != nil {
+			resp.Diagnostics.AddError("Error upgrading php version", err.Error())
+			return
+		}
+		// The version is still reported as installed until the upgrade starts.
+		if err := r.client.WaitForPHPVersionToStartUpdating(ctx, serverID, version); err != nil {
+			resp.Diagnostics.AddError("Error waiting for php version upgrade to start", err.Error())
+			return
+		}
+	}
+
+	php, err := r.client.WaitForPHPVersionToBeInstalled(ctx, serverID, version)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for php version to be installed", err.Error())
+		return
+	}
+
+	setForgeServerPHPState(&plan, php)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerPHPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Forge has no API to uninstall a PHP version; destroying the resource only stops managing it.
+}
+
+func (r *ForgeServerPHPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:version"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:version")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+
+	php, err := r.client.GetPHPVersion(ctx, int(serverID), parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading php version", err.Error())
+		return
+	}
+
+	var stateModel ForgeServerPHPResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.OPCache = types.BoolNull()
+	stateModel.UpgradePatchTriggers = types.MapNull(types.StringType)
+	setForgeServerPHPState(&stateModel, php)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setOPCache enables or disables OPCache on the server.
+func (r *ForgeServerPHPResource) setOPCache(ctx context.Context, serverID int, enabled bool) error {
+	if enabled {
+		return r.client.EnableOPCache(ctx, serverID)
+	}
+	return r.client.DisableOPCache(ctx, serverID)
+}
+
+// opcacheEnabled reports whether the server has OPCache turned on.
+func opcacheEnabled(server *forge_client.Server) bool {
+	return server.OpcacheStatus != nil && (*server.OpcacheStatus == "installed" || *server.OpcacheStatus == "enabled")
+}
+
+// setForgeServerPHPState copies the attributes returned by the API onto the model.
+func setForgeServerPHPState(model *ForgeServerPHPResourceModel, php *forge_client.PHPVersion) {
+	model.ID = types.Int64Value(int64(php.ID))
+	model.Version = types.StringValue(php.Version)
+	model.DisplayableVersion = types.StringValue(php.DisplayableVersion)
+	model.BinaryName = types.StringValue(php.BinaryName)
+	model.Status = types.StringValue(php.Status)
+	model.UsedAsDefault = types.BoolValue(php.UsedAsDefault)
+	model.UsedOnCLI = types.BoolValue(php.UsedOnCLI)
+}
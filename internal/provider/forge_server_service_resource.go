@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeServerServiceResource{}
+
+// ForgeServerServiceResource implements a Terraform resource for the running state of a service on a Forge server.
+type ForgeServerServiceResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeServerServiceResourceModel struct {
+	ServerID        types.Int64  `tfsdk:"server_id"`
+	Service         types.String `tfsdk:"service"`
+	PHPVersion      types.String `tfsdk:"php_version"`
+	State           types.String `tfsdk:"state"`
+	RestartTriggers types.Map    `tfsdk:"restart_triggers"`
+}
+
+func NewForgeServerServiceResource() resource.Resource {
+	return &ForgeServerServiceResource{}
+}
+
+func (r *ForgeServerServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_server_service"
+}
+
+func (r *ForgeServerServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge server service resource. This resource declares whether a service on a Forge server is running or stopped, and restarts it when its triggers change. " +
+			"Forge does not report the state of services, so changes made outside Terraform are not detected. Destroying the resource leaves the service as it is.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the service runs on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The service to manage. Valid values are `nginx`, `mysql`, `postgres` and `php`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"php_version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The PHP version whose FPM service is managed, e.g. `php83`. Required when `service` is `php`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("running"),
+				MarkdownDescription: "The desired state of the service. Valid values are `running` and `stopped`; `php` can only be `running`. Default is 'running'.",
+			},
+			"restart_triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary map of values that, when changed, restarts the service.",
+			},
+		},
+	}
+}
+
+func (r *ForgeServerServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeServerServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeServerServiceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := plan.Service.ValueString()
+	switch service {
+	case "nginx", "mysql", "postgres":
+	case "php":
+		if plan.PHPVersion.ValueString() == "" {
+			resp.Diagnostics.AddError("Missing php_version", "php_version is required when service is 'php'.")
+			return
+		}
+	default:
+		resp.Diagnostics.AddError("Invalid service", fmt.Sprintf("Expected 'nginx', 'mysql', 'postgres' or 'php', got: %q", service))
+		return
+	}
+
+	if err := r.applyServiceState(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error changing %s state", service), err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeServerServiceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Forge has no API to read the state of a service, so only check that the server still exists.
+	_, err := r.client.GetServer(ctx, int(state.ServerID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading server", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ForgeServerServiceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := plan.Service.ValueString()
+
+	// A service that is started or stopped in this apply needs no separate restart.
+	if !plan.State.Equal(state.State) {
+		if err := r.applyServiceState(ctx, plan); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error changing %s state", service), err.Error())
+			return
+		}
+	} else if !plan.RestartTriggers.Equal(state.RestartTriggers) && plan.State.ValueString() == "running" {
+		if err := r.restartService(ctx, plan); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error restarting %s", service), err.Error())
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeServerServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Services are part of the server; destroying the resource only stops managing it.
+}
+
+// applyServiceState starts or stops the service to match the planned state.
+func (r *ForgeServerServiceResource) applyServiceState(ctx context.Context, model ForgeServerServiceResourceModel) error {
+	serverID := int(model.ServerID.ValueInt64())
+	service := model.Service.ValueString()
+
+	switch model.State.ValueString() {
+	case "running":
+		if service == "php" {
+			return r.client.RebootPHP(ctx, serverID, model.PHPVersion.ValueString())
+		}
+		return r.client.StartService(ctx, serverID, service)
+	case "stopped":
+		switch service {
+		case "nginx":
+			return r.client.StopNginx(ctx, serverID)
+		case "mysql":
+			return r.client.StopMySQL(ctx, serverID)
+		case "postgres":
+			return r.client.StopPostgres(ctx, serverID)
+		default:
+			return fmt.Errorf("forge cannot stop the %s service", service)
+		}
+	default:
+		return fmt.Errorf("invalid state %q, expected 'running' or 'stopped'", model.State.ValueString())
+	}
+}
+
+// restartService restarts the service using its dedicated reboot endpoint.
+func (r *ForgeServerServiceResource) restartService(ctx context.Context, model ForgeServerServiceResourceModel) error {
+	serverID := int(model.ServerID.ValueInt64())
+
+	switch service := model.Service.ValueString(); service {
+	case "nginx":
+		return r.client.RebootNginx(ctx, serverID)
+	case "mysql":
+		return r.client.RebootMySQL(ctx, serverID)
+	case "postgres":
+		return r.client.RebootPostgres(ctx, serverID)
+	case "php":
+		return r.client.RebootPHP(ctx, serverID, model.PHPVersion.ValueString())
+	default:
+		return r.client.RestartService(ctx, serverID, service)
+	}
+}
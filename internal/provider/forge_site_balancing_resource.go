@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteBalancingResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteBalancingResource{}
+
+var balancingNodeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"server_id": types.Int64Type,
+		"weight":    types.Int64Type,
+		"port":      types.Int64Type,
+		"backup":    types.BoolType,
+		"down":      types.BoolType,
+	},
+}
+
+// ForgeSiteBalancingResource implements a Terraform resource for the upstream servers of a Forge load balancer site.
+type ForgeSiteBalancingResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteBalancingResourceModel struct {
+	ServerID types.Int64  `tfsdk:"server_id"`
+	SiteID   types.Int64  `tfsdk:"site_id"`
+	Method   types.String `tfsdk:"method"`
+	Nodes    types.Set    `tfsdk:"nodes"`
+}
+
+type ForgeSiteBalancingNodeModel struct {
+	ServerID types.Int64 `tfsdk:"server_id"`
+	Weight   types.Int64 `tfsdk:"weight"`
+	Port     types.Int64 `tfsdk:"port"`
+	Backup   types.Bool  `tfsdk:"backup"`
+	Down     types.Bool  `tfsdk:"down"`
+}
+
+func NewForgeSiteBalancingResource() resource.Resource {
+	return &ForgeSiteBalancingResource{}
+}
+
+func (r *ForgeSiteBalancingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_balancing"
+}
+
+func (r *ForgeSiteBalancingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site balancing resource. This resource manages the servers a site on a Forge load balancer distributes traffic to. " +
+			"Forge always requires at least one server, so destroying the resource leaves the last configuration in place.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the load balancer server.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the load balanced site.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"method": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("round_robin"),
+				MarkdownDescription: "The balancing method. Valid values are `round_robin`, `least_conn` and `ip_hash`. Default is 'round_robin'.",
+			},
+			"nodes": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The servers traffic is distributed to.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_id": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "The ID of the upstream server.",
+						},
+						"weight": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(1),
+							MarkdownDescription: "The relative share of requests the server receives. Default is 1.",
+						},
+						"port": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(80),
+							MarkdownDescription: "The port requests are sent to. Default is 80.",
+						},
+						"backup": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the server only receives requests when the other servers are unavailable. Default is false.",
+						},
+						"down": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the server is marked as permanently unavailable. Default is false.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteBalancingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteBalancingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteBalancingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyBalancing(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteBalancingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteBalancingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodes, err := r.client.GetSiteBalancing(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading site balancing", err.Error())
+		return
+	}
+
+	// The API does not return the balancing method, so only the nodes are refreshed.
+	setVal, d := balancingNodesFromAPI(ctx, nodes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Nodes = setVal
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteBalancingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ForgeSiteBalancingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyBalancing(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteBalancingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A load balanced site always has upstream servers; destroying the resource only stops managing them.
+}
+
+func (r *ForgeSiteBalancingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+
+	nodes, err := r.client.GetSiteBalancing(ctx, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading site balancing", err.Error())
+		return
+	}
+
+	setVal, d := balancingNodesFromAPI(ctx, nodes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateModel ForgeSiteBalancingResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	// The API does not return the balancing method; a configured method other than the default is applied on the next apply.
+	stateModel.Method = types.StringValue("round_robin")
+	stateModel.Nodes = setVal
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// applyBalancing replaces the upstream servers and balancing method of the site with the planned ones.
+func (r *ForgeSiteBalancingResource) applyBalancing(ctx context.Context, model ForgeSiteBalancingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	method := model.Method.ValueString()
+	switch method {
+	case "round_robin", "least_conn", "ip_hash":
+	default:
+		diags.AddError("Invalid method", fmt.Sprintf("Expected 'round_robin', 'least_conn' or 'ip_hash', got: %q", method))
+		return diags
+	}
+
+	var nodes []ForgeSiteBalancingNodeModel
+	diags.Append(model.Nodes.ElementsAs(ctx, &nodes, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	payload := forge_client.UpdateBalancingRequest{
+		Servers: make([]forge_client.BalancingNode, 0, len(nodes)),
+		Method:  method,
+	}
+	for _, node := range nodes {
+		weight := int(node.Weight.ValueInt64())
+		port := int(node.Port.ValueInt64())
+		backup := node.Backup.ValueBool()
+		down := node.Down.ValueBool()
+		payload.Servers = append(payload.Servers, forge_client.BalancingNode{
+			ServerID: int(node.ServerID.ValueInt64()),
+			Weight:   &weight,
+			Port:     &port,
+			Backup:   &backup,
+			Down:     &down,
+		})
+	}
+
+	if err := r.client.UpdateSiteBalancing(ctx, int(model.ServerID.ValueInt64()), int(model.SiteID.ValueInt64()), payload); err != nil {
+		diags.AddError("Error updating site balancing", err.Error())
+		return diags
+	}
+	return diags
+}
+
+// balancingNodesFromAPI converts the nodes returned by the API into a set, filling in Forge's defaults
+// for the options it omits.
+func balancingNodesFromAPI(ctx context.Context, nodes []forge_client.BalancingNode) (types.Set, diag.Diagnostics) {
+	models := make([]ForgeSiteBalancingNodeModel, 0, len(nodes))
+	for _, node := range nodes {
+		model := ForgeSiteBalancingNodeModel{
+			ServerID: types.Int64Value(int64(node.ServerID)),
+			Weight:   types.Int64Value(1),
+			Port:     types.Int64Value(80),
+			Backup:   types.BoolValue(false),
+			Down:     types.BoolValue(false),
+		}
+		if node.Weight != nil {
+			model.Weight = types.Int64Value(int64(*node.Weight))
+		}
+		if node.Port != nil {
+			model.Port = types.Int64Value(int64(*node.Port))
+		}
+		if node.Backup != nil {
+			model.Backup = types.BoolValue(*node.Backup)
+		}
+		if node.Down != nil {
+			model.Down = types.BoolValue(*node.Down)
+		}
+		models = append(models, model)
+	}
+	return types.SetValueFrom(ctx, balancingNodeType, models)
+}
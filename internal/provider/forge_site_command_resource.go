@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteCommandResource{}
+
+// ForgeSiteCommandResource implements a Terraform resource for a command run on a Forge site.
+type ForgeSiteCommandResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteCommandResourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	ServerID  types.Int64  `tfsdk:"server_id"`
+	SiteID    types.Int64  `tfsdk:"site_id"`
+	Command   types.String `tfsdk:"command"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+	Status    types.String `tfsdk:"status"`
+	Output    types.String `tfsdk:"output"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func NewForgeSiteCommandResource() resource.Resource {
+	return &ForgeSiteCommandResource{}
+}
+
+func (r *ForgeSiteCommandResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_command"
+}
+
+func (r *ForgeSiteCommandResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site command resource. This resource runs a command in the directory of a Forge site, waits until it finishes and captures its output. " +
+			"The apply fails if the command does not finish successfully. The command runs again whenever `command` or `triggers` change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site to run the command on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"command": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The command to run, e.g. `php artisan migrate --force`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary map of values that, when changed, runs the command again.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The final status of the command, e.g. `finished`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The output of the command.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteCommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteCommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteCommandResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+	siteID := int(plan.SiteID.ValueInt64())
+	command := plan.Command.ValueString()
+
+	// Remember the newest existing command, so the one created below can be told apart from earlier runs.
+	existing, err := r.client.ListSiteCommandsWithoutCache(ctx, serverID, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing site commands", err.Error())
+		return
+	}
+	var lastID int64
+	for _, cmd := range existing {
+		if cmd.ID > lastID {
+			lastID = cmd.ID
+		}
+	}
+
+	if err := r.client.ExecuteSiteCommand(ctx, serverID, siteID, command); err != nil {
+		resp.Diagnostics.AddError("Error executing site command", err.Error())
+		return
+	}
+
+	created, err := r.client.WaitForSiteCommandToBeCreated(ctx, serverID, siteID, command, lastID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding executed site command", err.Error())
+		return
+	}
+
+	finished, output, err := r.client.WaitForSiteCommandToFinish(ctx, serverID, siteID, int(created.ID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for site command to finish", err.Error())
+		return
+	}
+	if !finished.Successful() {
+		resp.Diagnostics.AddError(
+			"Site command failed",
+			fmt.Sprintf("The command %q finished with status %q.\n\nOutput:\n%s", command, finished.Status, output),
+		)
+		return
+	}
+
+	plan.ID = types.Int64Value(finished.ID)
+	plan.Status = types.StringValue(finished.Status)
+	plan.Output = types.StringValue(output)
+	plan.CreatedAt = types.StringValue(finished.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteCommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteCommandResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cmd, output, err := r.client.GetSiteCommand(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()), int(state.ID.ValueInt64()))
+	if err != nil {
+		// Forge prunes its command history; a command that has run stays run, so keep the recorded result.
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading site command", err.Error())
+		return
+	}
+
+	state.Status = types.StringValue(cmd.Status)
+	state.Output = types.StringValue(output)
+	state.CreatedAt = types.StringValue(cmd.CreatedAt)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// A command cannot be changed once it has run; every attribute forces a replacement.
+	var plan ForgeSiteCommandResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteCommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Commands cannot be undone or removed from the history; destroying the resource only forgets the result.
+}
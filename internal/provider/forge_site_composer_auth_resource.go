@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteComposerAuthResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteComposerAuthResource{}
+
+var composerCredentialType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"username": types.StringType,
+		"password": types.StringType,
+	},
+}
+
+// ForgeSiteComposerAuthResource implements a Terraform resource for the Composer package credentials of a Forge site.
+type ForgeSiteComposerAuthResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteComposerAuthResourceModel struct {
+	ServerID    types.Int64 `tfsdk:"server_id"`
+	SiteID      types.Int64 `tfsdk:"site_id"`
+	Credentials types.Map   `tfsdk:"credentials"`
+}
+
+type ForgeSiteComposerCredentialModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+func NewForgeSiteComposerAuthResource() resource.Resource {
+	return &ForgeSiteComposerAuthResource{}
+}
+
+func (r *ForgeSiteComposerAuthResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_composer_auth"
+}
+
+func (r *ForgeSiteComposerAuthResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site Composer authentication resource. This resource manages the credentials Composer uses for private package repositories on a Forge site. " +
+			"Credentials for repositories that are not configured here are left untouched. " +
+			"Import with `server_id:site_id:repository[,repository...]`; only the listed repositories are adopted.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site the credentials belong to.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"credentials": schema.MapNestedAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The credentials keyed by repository host, e.g. `nova.laravel.com`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The username, or the account email for Laravel Nova and Spark.",
+						},
+						"password": schema.StringAttribute{
+							Required:            true,
+							Sensitive:           true,
+							MarkdownDescription: "The password or license key.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteComposerAuthResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteComposerAuthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteComposerAuthResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var credentials map[string]ForgeSiteComposerCredentialModel
+	resp.Diagnostics.Append(plan.Credentials.ElementsAs(ctx, &credentials, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyComposerAuth(ctx, plan, credentials, nil); err != nil {
+		resp.Diagnostics.AddError("Error updating composer credentials", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteComposerAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteComposerAuthResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed map[string]ForgeSiteComposerCredentialModel
+	resp.Diagnostics.Append(state.Credentials.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	auth, err := r.client.GetComposerPackagesAuth(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading composer credentials", err.Error())
+		return
+	}
+
+	// Only the repositories managed by this resource are refreshed; the others belong to someone else.
+	credentials := map[string]ForgeSiteComposerCredentialModel{}
+	for repository := range managed {
+		if credential, ok := auth.Credentials[repository]; ok {
+			credentials[repository] = composerCredentialFromAPI(credential)
+		}
+	}
+	if len(credentials) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapVal, d := types.MapValueFrom(ctx, composerCredentialType, credentials)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Credentials = mapVal
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteComposerAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ForgeSiteComposerAuthResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var credentials, previous map[string]ForgeSiteComposerCredentialModel
+	resp.Diagnostics.Append(plan.Credentials.ElementsAs(ctx, &credentials, false)...)
+	resp.Diagnostics.Append(state.Credentials.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var removed []string
+	for repository := range previous {
+		if _, ok := credentials[repository]; !ok {
+			removed = append(removed, repository)
+		}
+	}
+
+	if err := r.applyComposerAuth(ctx, plan, credentials, removed); err != nil {
+		resp.Diagnostics.AddError("Error updating composer credentials", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteComposerAuthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeSiteComposerAuthResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed map[string]ForgeSiteComposerCredentialModel
+	resp.Diagnostics.Append(state.Credentials.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removed := make([]string, 0, len(managed))
+	for repository := range managed {
+		removed = append(removed, repository)
+	}
+
+	err := r.applyComposerAuth(ctx, state, nil, removed)
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error removing composer credentials", err.Error())
+		return
+	}
+}
+
+func (r *ForgeSiteComposerAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id:repository[,repository...]"
+	parts := splitCompositeID(req.ID, 3)
+	if parts == nil || parts[2] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id:repository[,repository...]")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+
+	auth, err := r.client.GetComposerPackagesAuth(ctx, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading composer credentials", err.Error())
+		return
+	}
+
+	// Only the named repositories are adopted, so the credentials of other repositories stay unmanaged.
+	credentials := map[string]ForgeSiteComposerCredentialModel{}
+	for _, repository := range strings.Split(parts[2], ",") {
+		credential, ok := auth.Credentials[repository]
+		if !ok {
+			resp.Diagnostics.AddError("Composer credentials not found", fmt.Sprintf("No credentials for repository %q on site %d of server %d.", repository, siteID, serverID))
+			return
+		}
+		credentials[repository] = composerCredentialFromAPI(credential)
+	}
+
+	mapVal, d := types.MapValueFrom(ctx, composerCredentialType, credentials)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateModel ForgeSiteComposerAuthResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	stateModel.Credentials = mapVal
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// applyComposerAuth merges the given credentials into those already on the site, dropping the
+// removed repositories, and writes the result back. Forge replaces the full list on every update.
+func (r *ForgeSiteComposerAuthResource) applyComposerAuth(ctx context.Context, model ForgeSiteComposerAuthResourceModel, credentials map[string]ForgeSiteComposerCredentialModel, removed []string) error {
+	serverID := int(model.ServerID.ValueInt64())
+	siteID := int(model.SiteID.ValueInt64())
+
+	auth, err := r.client.GetComposerPackagesAuthWithoutCache(ctx, serverID, siteID)
+	if err != nil {
+		return err
+	}
+
+	merged := make(map[string]forge_client.ComposerPackageCredentialRequest, len(auth.Credentials)+len(credentials))
+	for repository, credential := range auth.Credentials {
+		merged[repository] = forge_client.ComposerPackageCredentialRequest{
+			RepositoryURL: repository,
+			Username:      credential["username"],
+			Password:      credential["password"],
+		}
+	}
+	for _, repository := range removed {
+		delete(merged, repository)
+	}
+	for repository, credential := range credentials {
+		merged[repository] = forge_client.ComposerPackageCredentialRequest{
+			RepositoryURL: repository,
+			Username:      credential.Username.ValueString(),
+			Password:      credential.Password.ValueString(),
+		}
+	}
+
+	repositories := make([]string, 0, len(merged))
+	for repository := range merged {
+		repositories = append(repositories, repository)
+	}
+	sort.Strings(repositories)
+
+	payload := forge_client.UpdateComposerPackagesAuthRequest{
+		Credentials: make([]forge_client.ComposerPackageCredentialRequest, 0, len(repositories)),
+	}
+	for _, repository := range repositories {
+		payload.Credentials = append(payload.Credentials, merged[repository])
+	}
+
+	return r.client.UpdateComposerPackagesAuth(ctx, serverID, siteID, payload)
+}
+
+// composerCredentialFromAPI converts a credential returned by the API into its model.
+func composerCredentialFromAPI(credential map[string]string) ForgeSiteComposerCredentialModel {
+	return ForgeSiteComposerCredentialModel{
+		Username: types.StringValue(credential["username"]),
+		Password: types.StringValue(credential["password"]),
+	}
+}
@@ -0,0 +1,520 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteEnvResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteEnvResource{}
+var _ resource.ResourceWithModifyPlan = &ForgeSiteEnvResource{}
+
+// siteEnvVariablesKey is the private state key holding the names of the managed variables,
+// so removed variables can be dropped from the file and drift is only checked for managed ones.
+const siteEnvVariablesKey = "variables"
+
+// ForgeSiteEnvResource implements a Terraform resource for the .env file of a Forge site.
+type ForgeSiteEnvResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteEnvResourceModel struct {
+	ServerID    types.Int64  `tfsdk:"server_id"`
+	SiteID      types.Int64  `tfsdk:"site_id"`
+	Content     types.String `tfsdk:"content"`
+	Variables   types.Map    `tfsdk:"variables"`
+	ContentHash types.String `tfsdk:"content_hash"`
+}
+
+func NewForgeSiteEnvResource() resource.Resource {
+	return &ForgeSiteEnvResource{}
+}
+
+func (r *ForgeSiteEnvResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_env"
+}
+
+func (r *ForgeSiteEnvResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site environment resource. This resource allows you to manage the `.env` file of a Forge site, either as raw `content` or as a map of `variables`. " +
+			"Both are write-only: the values are never stored in state, only their SHA-256 `content_hash`, which is also how changes made on the server are detected. " +
+			"Requires Terraform 1.11 or later. Destroying the resource leaves the file on the server untouched.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site whose `.env` file is managed.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The full content of the `.env` file. Conflicts with `variables`.",
+			},
+			"variables": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Variables to set in the `.env` file. Other variables already in the file are kept; variables removed from the map are removed from the file. Conflicts with `content`.",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 hash of the managed values. A change means the file was edited on the server.",
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteEnvResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteEnvResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The values are write-only, so they are only available from the configuration.
+	var config ForgeSiteEnvResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Content.IsUnknown() || config.Variables.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringUnknown())...)
+		return
+	}
+
+	// Plan the hash of the configured values, so drift on the server shows up as a change.
+	hash, diags := siteEnvDesiredHash(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), hash)...)
+}
+
+func (r *ForgeSiteEnvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config ForgeSiteEnvResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := r.applySiteEnv(ctx, config, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash, diags := siteEnvDesiredHash(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ContentHash = hash
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setSiteEnvManagedKeys(ctx, resp.Private, managed)...)
+}
+
+func (r *ForgeSiteEnvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteEnvResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := getSiteEnvManagedKeys(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := r.client.GetEnvFile(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading environment file", err.Error())
+		return
+	}
+
+	state.ContentHash = types.StringValue(siteEnvActualHash(content, managed))
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteEnvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config ForgeSiteEnvResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := getSiteEnvManagedKeys(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := r.applySiteEnv(ctx, config, previous)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash, diags := siteEnvDesiredHash(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ContentHash = hash
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setSiteEnvManagedKeys(ctx, resp.Private, managed)...)
+}
+
+func (r *ForgeSiteEnvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A site always has an environment file; destroying the resource only stops managing it.
+}
+
+func (r *ForgeSiteEnvResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+
+	content, err := r.client.GetEnvFile(ctx, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading environment file", err.Error())
+		return
+	}
+
+	// The values are write-only; the configured ones are written on the next apply.
+	var stateModel ForgeSiteEnvResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	stateModel.Content = types.StringNull()
+	stateModel.Variables = types.MapNull(types.StringType)
+	stateModel.ContentHash = types.StringValue(envContentHash(content))
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// applySiteEnv writes the configured content or variables to the site and returns the names of the managed
+// variables, or nil when the whole file is managed. previous holds the variables managed before this apply,
+// so removed keys can be dropped from the file.
+func (r *ForgeSiteEnvResource) applySiteEnv(ctx context.Context, config ForgeSiteEnvResourceModel, previous []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hasContent := !config.Content.IsNull()
+	hasVariables := !config.Variables.IsNull()
+	if hasContent == hasVariables {
+		diags.AddError("Invalid configuration", "Exactly one of 'content' or 'variables' must be set.")
+		return nil, diags
+	}
+
+	serverID := int(config.ServerID.ValueInt64())
+	siteID := int(config.SiteID.ValueInt64())
+
+	content := config.Content.ValueString()
+	var managed []string
+	if hasVariables {
+		var variables map[string]string
+		diags.Append(config.Variables.ElementsAs(ctx, &variables, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		current, err := r.client.GetEnvFile(ctx, serverID, siteID)
+		if err != nil {
+			diags.AddError("Error reading environment file", err.Error())
+			return nil, diags
+		}
+
+		var removed []string
+		for _, key := range previous {
+			if _, ok := variables[key]; !ok {
+				removed = append(removed, key)
+			}
+		}
+		content = mergeEnvVariables(current, variables, removed)
+
+		managed = make([]string, 0, len(variables))
+		for key := range variables {
+			managed = append(managed, key)
+		}
+		sort.Strings(managed)
+	}
+
+	if err := r.client.UpdateEnvFile(ctx, serverID, siteID, content); err != nil {
+		diags.AddError("Error updating environment file", err.Error())
+		return nil, diags
+	}
+
+	return managed, diags
+}
+
+// privateStateGetter and privateStateSetter are satisfied by the private state of resource requests and responses.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getSiteEnvManagedKeys returns the names of the managed variables, or nil when the whole file is managed.
+func getSiteEnvManagedKeys(ctx context.Context, private privateStateGetter) ([]string, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, siteEnvVariablesKey)
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	managed := []string{}
+	if err := json.Unmarshal(data, &managed); err != nil {
+		diags.AddError("Error reading managed variables", err.Error())
+	}
+	return managed, diags
+}
+
+// setSiteEnvManagedKeys records the names of the managed variables; nil clears them.
+func setSiteEnvManagedKeys(ctx context.Context, private privateStateSetter, managed []string) diag.Diagnostics {
+	if managed == nil {
+		return private.SetKey(ctx, siteEnvVariablesKey, nil)
+	}
+
+	var diags diag.Diagnostics
+	data, err := json.Marshal(managed)
+	if err != nil {
+		diags.AddError("Error saving managed variables", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, siteEnvVariablesKey, data)
+}
+
+// siteEnvDesiredHash returns the hash of the configured content or variables.
+func siteEnvDesiredHash(ctx context.Context, config ForgeSiteEnvResourceModel) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !config.Variables.IsNull() {
+		var variables map[string]string
+		diags.Append(config.Variables.ElementsAs(ctx, &variables, false)...)
+		return types.StringValue(envVariablesHash(variables)), diags
+	}
+	if !config.Content.IsNull() {
+		return types.StringValue(envContentHash(config.Content.ValueString())), diags
+	}
+	return types.StringNull(), diags
+}
+
+// siteEnvActualHash returns the hash of the values on the server that are managed: the named variables,
+// or the whole file when managed is nil.
+func siteEnvActualHash(content string, managed []string) string {
+	if managed == nil {
+		return envContentHash(content)
+	}
+
+	onServer := parseEnvFile(content)
+	values := make(map[string]string, len(managed))
+	for _, key := range managed {
+		if value, ok := onServer[key]; ok {
+			values[key] = value
+		}
+	}
+	return envVariablesHash(values)
+}
+
+// envVariablesHash hashes the variables in a stable, key-sorted form.
+func envVariablesHash(variables map[string]string) string {
+	keys := make([]string, 0, len(variables))
+	for key := range variables {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		b.WriteString(key)
+		b.WriteString("=")
+		b.WriteString(strconv.Quote(variables[key]))
+		b.WriteString("\n")
+	}
+	return sha256Hex(b.String())
+}
+
+// envContentHash hashes raw file content, ignoring trailing newlines the server may add or strip.
+func envContentHash(content string) string {
+	return sha256Hex(strings.TrimRight(content, "\n"))
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// parseEnvFile returns the variables defined in a .env file, with surrounding quotes removed.
+func parseEnvFile(content string) map[string]string {
+	variables := map[string]string{}
+	for _, line := range strings.Split(content, "\n") {
+		key, value, ok := parseEnvLine(line)
+		if ok {
+			variables[key] = value
+		}
+	}
+	return variables
+}
+
+func parseEnvLine(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", "", false
+	}
+	line = strings.TrimPrefix(line, "export ")
+	key, value, ok := strings.Cut(line, "=")
+	if !ok {
+		return "", "", false
+	}
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 {
+		switch {
+		case value[0] == '"' && value[len(value)-1] == '"':
+			value = strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(value[1 : len(value)-1])
+		case value[0] == '\'' && value[len(value)-1] == '\'':
+			value = value[1 : len(value)-1]
+		}
+	}
+	return key, value, true
+}
+
+// formatEnvValue quotes a value when it would otherwise not survive a round trip through a .env parser.
+func formatEnvValue(value string) string {
+	if value == "" || !strings.ContainsAny(value, " \t#\"'\\$") {
+		return value
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// mergeEnvVariables sets the given variables in the content, removes the listed keys and keeps everything else.
+func mergeEnvVariables(content string, variables map[string]string, removed []string) string {
+	remove := make(map[string]bool, len(removed))
+	for _, key := range removed {
+		remove[key] = true
+	}
+
+	written := map[string]bool{}
+	var existing []string
+	if content = strings.TrimRight(content, "\n"); content != "" {
+		existing = strings.Split(content, "\n")
+	}
+
+	var lines []string
+	for _, line := range existing {
+		key, _, ok := parseEnvLine(line)
+		if ok {
+			if value, managed := variables[key]; managed {
+				if !written[key] {
+					lines = append(lines, key+"="+formatEnvValue(value))
+					written[key] = true
+				}
+				continue
+			}
+			if remove[key] {
+				continue
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	var missing []string
+	for key := range variables {
+		if !written[key] {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	for _, key := range missing {
+		lines = append(lines, key+"="+formatEnvValue(variables[key]))
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
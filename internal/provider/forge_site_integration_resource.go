@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteIntegrationResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteIntegrationResource{}
+
+// ForgeSiteIntegrationResource implements a Terraform resource for the Horizon, Pulse and Inertia integrations of a Forge site.
+type ForgeSiteIntegrationResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteIntegrationResourceModel struct {
+	ServerID       types.Int64  `tfsdk:"server_id"`
+	SiteID         types.Int64  `tfsdk:"site_id"`
+	Type           types.String `tfsdk:"type"`
+	DeploysRestart types.Bool   `tfsdk:"deploys_restart"`
+	DaemonID       types.Int64  `tfsdk:"daemon_id"`
+}
+
+func NewForgeSiteIntegrationResource() resource.Resource {
+	return &ForgeSiteIntegrationResource{}
+}
+
+func (r *ForgeSiteIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_integration"
+}
+
+func (r *ForgeSiteIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site integration resource. This resource allows you to enable the Horizon, Pulse and Inertia SSR integrations on Forge sites. " +
+			"Disabling the integration in the Forge UI shows up as drift and enables it again on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site to enable the integration on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The integration to enable. Valid values are `horizon`, `pulse` and `inertia`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deploys_restart": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether deployments restart the Inertia SSR daemon. Only valid for the `inertia` type. Default is false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"daemon_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the daemon Forge created to run the integration.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationType := plan.Type.ValueString()
+	serverID := int(plan.ServerID.ValueInt64())
+	siteID := int(plan.SiteID.ValueInt64())
+
+	if plan.DeploysRestart.ValueBool() && integrationType != "inertia" {
+		resp.Diagnostics.AddError("Invalid deploys_restart", "deploys_restart can only be set for the 'inertia' integration.")
+		return
+	}
+
+	var daemon *forge_client.IntegrationDaemon
+	var err error
+	switch integrationType {
+	case "horizon":
+		daemon, err = r.client.EnableHorizon(ctx, serverID, siteID)
+	case "pulse":
+		daemon, err = r.client.EnablePulse(ctx, serverID, siteID)
+	case "inertia":
+		daemon, err = r.client.EnableInertia(ctx, serverID, siteID, plan.DeploysRestart.ValueBool())
+	default:
+		resp.Diagnostics.AddError("Invalid type", fmt.Sprintf("Expected 'horizon', 'pulse' or 'inertia', got: %q", integrationType))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error enabling %s", integrationType), err.Error())
+		return
+	}
+
+	plan.DaemonID = types.Int64Value(int64(daemon.ID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled, daemon, err := r.checkIntegration(ctx, state.Type.ValueString(), int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading %s status", state.Type.ValueString()), err.Error())
+		return
+	}
+	if !enabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if daemon != nil {
+		state.DaemonID = types.Int64Value(int64(daemon.ID))
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No update API exists for integrations; every attribute forces a replacement.
+	var plan ForgeSiteIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeSiteIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(state.ServerID.ValueInt64())
+	siteID := int(state.SiteID.ValueInt64())
+
+	var err error
+	switch state.Type.ValueString() {
+	case "horizon":
+		err = r.client.DisableHorizon(ctx, serverID, siteID)
+	case "pulse":
+		err = r.client.DisablePulse(ctx, serverID, siteID)
+	case "inertia":
+		err = r.client.DisableInertia(ctx, serverID, siteID)
+	}
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error disabling %s", state.Type.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *ForgeSiteIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id:type"
+	parts := splitCompositeID(req.ID, 3)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id:type")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+	integrationType := parts[2]
+
+	enabled, daemon, err := r.checkIntegration(ctx, integrationType, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading %s status", integrationType), err.Error())
+		return
+	}
+	if !enabled {
+		resp.Diagnostics.AddError("Integration not enabled", fmt.Sprintf("%s is not enabled on site %d of server %d.", integrationType, siteID, serverID))
+		return
+	}
+
+	var stateModel ForgeSiteIntegrationResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	stateModel.Type = types.StringValue(integrationType)
+	// The status endpoint does not report the Inertia restart option; assume the default.
+	stateModel.DeploysRestart = types.BoolValue(false)
+	stateModel.DaemonID = types.Int64Null()
+	if daemon != nil {
+		stateModel.DaemonID = types.Int64Value(int64(daemon.ID))
+	}
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// checkIntegration reports whether the integration is enabled on the site, along with its daemon if any.
+func (r *ForgeSiteIntegrationResource) checkIntegration(ctx context.Context, integrationType string, serverID, siteID int) (bool, *forge_client.IntegrationDaemon, error) {
+	switch integrationType {
+	case "horizon":
+		status, err := r.client.CheckHorizonStatus(ctx, serverID, siteID)
+		if err != nil {
+			return false, nil, err
+		}
+		return status.Enabled, status.Daemon, nil
+	case "pulse":
+		status, err := r.client.CheckPulseStatus(ctx, serverID, siteID)
+		if err != nil {
+			return false, nil, err
+		}
+		return status.Enabled, status.Daemon, nil
+	case "inertia":
+		status, err := r.client.CheckInertiaStatus(ctx, serverID, siteID)
+		if err != nil {
+			return false, nil, err
+		}
+		return status.Enabled, status.Daemon, nil
+	default:
+		return false, nil, fmt.Errorf("unknown integration type %q, expected 'horizon', 'pulse' or 'inertia'", integrationType)
+	}
+}
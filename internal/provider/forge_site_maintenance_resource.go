@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteMaintenanceResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteMaintenanceResource{}
+
+// ForgeSiteMaintenanceResource implements a Terraform resource for the Laravel maintenance mode of a Forge site.
+type ForgeSiteMaintenanceResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteMaintenanceResourceModel struct {
+	ServerID types.Int64  `tfsdk:"server_id"`
+	SiteID   types.Int64  `tfsdk:"site_id"`
+	Secret   types.String `tfsdk:"secret"`
+	Status   types.Int64  `tfsdk:"status"`
+}
+
+func NewForgeSiteMaintenanceResource() resource.Resource {
+	return &ForgeSiteMaintenanceResource{}
+}
+
+func (r *ForgeSiteMaintenanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_maintenance"
+}
+
+func (r *ForgeSiteMaintenanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site maintenance resource. This resource puts a Laravel site into maintenance mode with `php artisan down` " +
+			"while it exists, and takes the site out of maintenance mode when it is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site to put into maintenance mode.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret that bypasses maintenance mode when visited as `https://example.com/<secret>`.",
+			},
+			"status": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(503),
+				MarkdownDescription: "The HTTP status code returned while the site is in maintenance mode. Default is 503.",
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteMaintenanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteMaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteMaintenanceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.enableMaintenance(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteMaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteMaintenanceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := r.client.CheckLaravelMaintenance(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading maintenance mode status", err.Error())
+		return
+	}
+	// A site brought back up outside Terraform is put into maintenance mode again on the next apply.
+	if !status.Enabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteMaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ForgeSiteMaintenanceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Running `artisan down` again replaces the options without bringing the site up in between.
+	resp.Diagnostics.Append(r.enableMaintenance(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteMaintenanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeSiteMaintenanceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DisableLaravelMaintenance(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error disabling maintenance mode", err.Error())
+		return
+	}
+}
+
+func (r *ForgeSiteMaintenanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+
+	status, err := r.client.CheckLaravelMaintenance(ctx, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading maintenance mode status", err.Error())
+		return
+	}
+	if !status.Enabled {
+		resp.Diagnostics.AddError("Maintenance mode not enabled", fmt.Sprintf("Site %d of server %d is not in maintenance mode.", siteID, serverID))
+		return
+	}
+
+	var stateModel ForgeSiteMaintenanceResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	// The API returns neither the secret nor the HTTP status; they are applied from the configuration on the next apply.
+	stateModel.Secret = types.StringNull()
+	stateModel.Status = types.Int64Null()
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// enableMaintenance puts the site into maintenance mode with the planned secret and HTTP status.
+func (r *ForgeSiteMaintenanceResource) enableMaintenance(ctx context.Context, model ForgeSiteMaintenanceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := int(model.ServerID.ValueInt64())
+	siteID := int(model.SiteID.ValueInt64())
+
+	status, err := r.client.CheckLaravelMaintenance(ctx, serverID, siteID)
+	if err != nil {
+		diags.AddError("Error reading maintenance mode status", err.Error())
+		return diags
+	}
+	if !status.LaravelInstalled {
+		diags.AddError(
+			"Laravel not installed",
+			fmt.Sprintf("Maintenance mode can only be enabled on Laravel sites, but Forge does not detect Laravel on site %d of server %d.", siteID, serverID),
+		)
+		return diags
+	}
+
+	if err := r.client.EnableLaravelMaintenance(ctx, serverID, siteID, model.Secret.ValueString(), int(model.Status.ValueInt64())); err != nil {
+		diags.AddError("Error enabling maintenance mode", err.Error())
+		return diags
+	}
+	return diags
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteNginxConfigResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteNginxConfigResource{}
+
+// ForgeSiteNginxConfigResource implements a Terraform resource for the Nginx configuration file of a Forge site.
+type ForgeSiteNginxConfigResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteNginxConfigResourceModel struct {
+	ServerID types.Int64  `tfsdk:"server_id"`
+	SiteID   types.Int64  `tfsdk:"site_id"`
+	Content  types.String `tfsdk:"content"`
+}
+
+func NewForgeSiteNginxConfigResource() resource.Resource {
+	return &ForgeSiteNginxConfigResource{}
+}
+
+func (r *ForgeSiteNginxConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_nginx_config"
+}
+
+func (r *ForgeSiteNginxConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site Nginx configuration resource. This resource owns the full Nginx configuration file of a Forge site. " +
+			"Every change is validated with `nginx -t`; if the test fails, the previous configuration is restored and the apply fails. " +
+			"Destroying the resource leaves the file on the server untouched.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site whose Nginx configuration is managed.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The full content of the Nginx configuration file.",
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteNginxConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteNginxConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteNginxConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyNginxConfiguration(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteNginxConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteNginxConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := r.client.GetNginxConfiguration(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading nginx configuration", err.Error())
+		return
+	}
+
+	// Edits made in the Forge UI show up as a diff against the configured content.
+	state.Content = types.StringValue(content)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteNginxConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ForgeSiteNginxConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyNginxConfiguration(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteNginxConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A site always has an Nginx configuration; destroying the resource only stops managing it.
+}
+
+func (r *ForgeSiteNginxConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+
+	content, err := r.client.GetNginxConfiguration(ctx, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading nginx configuration", err.Error())
+		return
+	}
+
+	var stateModel ForgeSiteNginxConfigResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	stateModel.Content = types.StringValue(content)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// applyNginxConfiguration writes the configured content, tests it with `nginx -t` and restores
+// the previous content if the test fails.
+func (r *ForgeSiteNginxConfigResource) applyNginxConfiguration(ctx context.Context, model ForgeSiteNginxConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := int(model.ServerID.ValueInt64())
+	siteID := int(model.SiteID.ValueInt64())
+
+	previous, err := r.client.GetNginxConfigurationWithoutCache(ctx, serverID, siteID)
+	if err != nil {
+		diags.AddError("Error reading nginx configuration", err.Error())
+		return diags
+	}
+
+	if err := r.client.UpdateNginxConfiguration(ctx, serverID, siteID, model.Content.ValueString()); err != nil {
+		diags.AddError("Error updating nginx configuration", err.Error())
+		return diags
+	}
+
+	var failure string
+	test, err := r.client.TestNginx(ctx, serverID)
+	if err != nil {
+		failure = err.Error()
+	} else if !test.Successful() {
+		failure = test.Result
+	}
+	if failure == "" {
+		return diags
+	}
+
+	if err := r.client.UpdateNginxConfiguration(ctx, serverID, siteID, previous); err != nil {
+		diags.AddError(
+			"Error restoring nginx configuration",
+			fmt.Sprintf("The new configuration failed the nginx test and the previous configuration could not be restored: %s\n\nTest output:\n%s", err.Error(), failure),
+		)
+		return diags
+	}
+
+	diags.AddError(
+		"Invalid nginx configuration",
+		fmt.Sprintf("The new configuration failed the nginx test and the previous configuration was restored.\n\nTest output:\n%s", failure),
+	)
+	return diags
+}
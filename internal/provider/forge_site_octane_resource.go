@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteOctaneResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteOctaneResource{}
+
+// octaneDaemonOption matches the --port and --server options of the octane:start daemon command.
+var octaneDaemonOption = regexp.MustCompile(`--(port|server)[= ]([^\s'"]+)`)
+
+// ForgeSiteOctaneResource implements a Terraform resource for the Laravel Octane integration of a Forge site.
+type ForgeSiteOctaneResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteOctaneResourceModel struct {
+	ServerID types.Int64  `tfsdk:"server_id"`
+	SiteID   types.Int64  `tfsdk:"site_id"`
+	Port     types.Int64  `tfsdk:"port"`
+	Server   types.String `tfsdk:"server"`
+	DaemonID types.Int64  `tfsdk:"daemon_id"`
+}
+
+func NewForgeSiteOctaneResource() resource.Resource {
+	return &ForgeSiteOctaneResource{}
+}
+
+func (r *ForgeSiteOctaneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_octane"
+}
+
+func (r *ForgeSiteOctaneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site Octane resource. This resource enables Laravel Octane on a Forge site and waits until its daemon is running. " +
+			"Changing `port` or `server` disables Octane and enables it again with the new settings.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site to enable Octane on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(8000),
+				MarkdownDescription: "The port the Octane server listens on. Default is 8000.",
+			},
+			"server": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("swoole"),
+				MarkdownDescription: "The application server Octane runs on. Valid values are `swoole`, `roadrunner` and `frankenphp`. Default is 'swoole'.",
+			},
+			"daemon_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the daemon Forge created to run Octane.",
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteOctaneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteOctaneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteOctaneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.enableOctane(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteOctaneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteOctaneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := r.client.CheckOctaneStatus(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading octane status", err.Error())
+		return
+	}
+	if !status.Enabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setForgeSiteOctaneState(&state, status)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteOctaneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ForgeSiteOctaneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Port.Equal(state.Port) && plan.Server.Equal(state.Server) {
+		plan.DaemonID = state.DaemonID
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The Octane settings cannot be changed in place, so restart the integration with the new ones.
+	serverID := int(state.ServerID.ValueInt64())
+	siteID := int(state.SiteID.ValueInt64())
+	if err := r.client.DisableOctane(ctx, serverID, siteID); err != nil {
+		resp.Diagnostics.AddError("Error disabling octane", err.Error())
+		return
+	}
+	if err := r.client.WaitForOctaneToBeDisabled(ctx, serverID, siteID); err != nil {
+		resp.Diagnostics.AddError("Error waiting for octane to be disabled", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.enableOctane(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteOctaneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeSiteOctaneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DisableOctane(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error disabling octane", err.Error())
+		return
+	}
+}
+
+func (r *ForgeSiteOctaneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+
+	status, err := r.client.CheckOctaneStatus(ctx, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading octane status", err.Error())
+		return
+	}
+	if !status.Enabled {
+		resp.Diagnostics.AddError("Octane not enabled", fmt.Sprintf("Octane is not enabled on site %d of server %d.", siteID, serverID))
+		return
+	}
+
+	var stateModel ForgeSiteOctaneResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	stateModel.Port = types.Int64Value(8000)
+	stateModel.Server = types.StringValue("swoole")
+	setForgeSiteOctaneState(&stateModel, status)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// enableOctane enables Octane with the planned settings and waits until its daemon is running.
+func (r *ForgeSiteOctaneResource) enableOctane(ctx context.Context, model *ForgeSiteOctaneResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch model.Server.ValueString() {
+	case "swoole", "roadrunner", "frankenphp":
+	default:
+		diags.AddError("Invalid server", fmt.Sprintf("Expected 'swoole', 'roadrunner' or 'frankenphp', got: %q", model.Server.ValueString()))
+		return diags
+	}
+
+	serverID := int(model.ServerID.ValueInt64())
+	siteID := int(model.SiteID.ValueInt64())
+
+	if _, err := r.client.EnableOctane(ctx, serverID, siteID, int(model.Port.ValueInt64()), model.Server.ValueString()); err != nil {
+		diags.AddError("Error enabling octane", err.Error())
+		return diags
+	}
+
+	status, err := r.client.WaitForOctaneToBeRunning(ctx, serverID, siteID)
+	if err != nil {
+		diags.AddError("Error waiting for octane to start", err.Error())
+		return diags
+	}
+
+	model.DaemonID = types.Int64Value(int64(status.Daemon.ID))
+	return diags
+}
+
+// setForgeSiteOctaneState copies the daemon reported by the API onto the model. The status endpoint
+// does not return the port and server, so they are only refreshed when the daemon command names them.
+func setForgeSiteOctaneState(model *ForgeSiteOctaneResourceModel, status *forge_client.OctaneStatus) {
+	if status.Daemon == nil {
+		return
+	}
+	model.DaemonID = types.Int64Value(int64(status.Daemon.ID))
+	for _, match := range octaneDaemonOption.FindAllStringSubmatch(status.Daemon.Command, -1) {
+		switch match[1] {
+		case "port":
+			if port, err := strconv.ParseInt(match[2], 10, 64); err == nil {
+				model.Port = types.Int64Value(port)
+			}
+		case "server":
+			model.Server = types.StringValue(match[2])
+		}
+	}
+}
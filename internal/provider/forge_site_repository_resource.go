@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteRepositoryResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteRepositoryResource{}
+
+// ForgeSiteRepositoryResource implements a Terraform resource for the Git repository installed on a Forge site.
+type ForgeSiteRepositoryResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteRepositoryResourceModel struct {
+	ServerID           types.Int64  `tfsdk:"server_id"`
+	SiteID             types.Int64  `tfsdk:"site_id"`
+	RepositoryProvider types.String `tfsdk:"repository_provider"`
+	Repository         types.String `tfsdk:"repository"`
+	Branch             types.String `tfsdk:"branch"`
+	Composer           types.Bool   `tfsdk:"composer"`
+	Status             types.String `tfsdk:"status"`
+}
+
+func NewForgeSiteRepositoryResource() resource.Resource {
+	return &ForgeSiteRepositoryResource{}
+}
+
+func (r *ForgeSiteRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_repository"
+}
+
+func (r *ForgeSiteRepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site repository resource. This resource allows you to install a Git repository on a Forge site and waits until the installation has finished.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site to install the repository on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"repository_provider": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("github"),
+				MarkdownDescription: "The source control provider. Valid values are `github`, `gitlab`, `gitlab-custom`, `bitbucket` and `custom`. Default is 'github'.",
+			},
+			"repository": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The repository to install, e.g. `acme/app` or a Git URL for the `custom` provider.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("main"),
+				MarkdownDescription: "The branch to deploy. Default is 'main'.",
+			},
+			"composer": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether to install Composer dependencies when the repository is installed. Only used during the initial installation. Default is true.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The repository status reported by Forge.",
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteRepositoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+	siteID := int(plan.SiteID.ValueInt64())
+
+	payload := forge_client.GitProjectRequest{
+		Provider:   plan.RepositoryProvider.ValueString(),
+		Repository: plan.Repository.ValueString(),
+		Branch:     plan.Branch.ValueString(),
+		Composer:   plan.Composer.ValueBool(),
+	}
+
+	if err := r.client.InstallGitProject(ctx, serverID, siteID, payload); err != nil {
+		resp.Diagnostics.AddError("Error installing repository", err.Error())
+		return
+	}
+
+	site, err := r.client.WaitForGitProjectToBeInstalled(ctx, serverID, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for repository installation", err.Error())
+		return
+	}
+
+	plan.Status = types.StringValue(*site.RepositoryStatus)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteRepositoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site, err := r.client.GetSite(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading site", err.Error())
+		return
+	}
+
+	// The repository was removed outside of Terraform.
+	if site.Repository == nil || *site.Repository == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setForgeSiteRepositoryState(&state, site)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ForgeSiteRepositoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ForgeSiteRepositoryResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+	siteID := int(plan.SiteID.ValueInt64())
+
+	// The composer flag only applies to the initial installation, so it never triggers an API call.
+	if !plan.RepositoryProvider.Equal(state.RepositoryProvider) || !plan.Repository.Equal(state.Repository) || !plan.Branch.Equal(state.Branch) {
+		payload := forge_client.GitProjectRequest{
+			Provider:   plan.RepositoryProvider.ValueString(),
+			Repository: plan.Repository.ValueString(),
+			Branch:     plan.Branch.ValueString(),
+		}
+		if err := r.client.UpdateGitProject(ctx, serverID, siteID, payload); err != nil {
+			resp.Diagnostics.AddError("Error updating repository", err.Error())
+			return
+		}
+	}
+
+	site, err := r.client.GetSiteWithoutCache(ctx, serverID, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading site", err.Error())
+		return
+	}
+	plan.Status = types.StringNull()
+	if site.RepositoryStatus != nil {
+		plan.Status = types.StringValue(*site.RepositoryStatus)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeSiteRepositoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveGitProject(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error removing repository", err.Error())
+		return
+	}
+}
+
+func (r *ForgeSiteRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+
+	site, err := r.client.GetSite(ctx, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading site", err.Error())
+		return
+	}
+	if site.Repository == nil || *site.Repository == "" {
+		resp.Diagnostics.AddError("Repository not installed", fmt.Sprintf("Site %d on server %d has no repository installed.", siteID, serverID))
+		return
+	}
+
+	var stateModel ForgeSiteRepositoryResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	// Composer is only used during installation and cannot be read back.
+	stateModel.Composer = types.BoolValue(true)
+	setForgeSiteRepositoryState(&stateModel, site)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setForgeSiteRepositoryState copies the repository attributes of the site onto the model.
+func setForgeSiteRepositoryState(model *ForgeSiteRepositoryResourceModel, site *forge_client.Site) {
+	if site.Repository != nil {
+		model.Repository = types.StringValue(*site.Repository)
+	}
+	if site.RepositoryProvider != nil {
+		model.RepositoryProvider = types.StringValue(*site.RepositoryProvider)
+	}
+	if site.RepositoryBranch != nil {
+		model.Branch = types.StringValue(*site.RepositoryBranch)
+	}
+	model.Status = types.StringNull()
+	if site.RepositoryStatus != nil {
+		model.Status = types.StringValue(*site.RepositoryStatus)
+	}
+}
@@ -110,7 +110,8 @@ func (r *ForgeSiteResource) Schema(ctx context.Context, req resource.SchemaReque
 				Default:  stringdefault.StaticString("php82"), // Todo: Make this dynamic, or check if 'php' defaults to the system version.
 			},
 			"nginx_template": schema.StringAttribute{
-				Optional: true,
+				Optional:            true,
+				MarkdownDescription: "The Nginx template to use for the site. Pass the `id` of a `laravel_forge_nginx_template` resource or data source.",
 			},
 			"wildcards": schema.BoolAttribute{
 				Optional: true,
@@ -200,7 +201,12 @@ func (r *ForgeSiteResource) Create(ctx context.Context, req resource.CreateReque
 		payload.Database = plan.Database.ValueString()
 	}
 	if !plan.NginxTemplate.IsNull() && plan.NginxTemplate.ValueString() != "" {
-		payload.NginxTemplate = plan.NginxTemplate.ValueString()
+		// Forge expects a numeric template ID; keep any other value (e.g. "default") as-is.
+		if templateID, err := strconv.Atoi(plan.NginxTemplate.ValueString()); err == nil {
+			payload.NginxTemplate = templateID
+		} else {
+			payload.NginxTemplate = plan.NginxTemplate.ValueString()
+		}
 	}
 
 	// Call CreateSite on the client using the provided server_id.
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteReverbResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteReverbResource{}
+
+// ForgeSiteReverbResource implements a Terraform resource for the Laravel Reverb integration of a Forge site.
+type ForgeSiteReverbResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteReverbResourceModel struct {
+	ServerID    types.Int64  `tfsdk:"server_id"`
+	SiteID      types.Int64  `tfsdk:"site_id"`
+	Host        types.String `tfsdk:"host"`
+	Port        types.Int64  `tfsdk:"port"`
+	Connections types.Int64  `tfsdk:"connections"`
+	DaemonID    types.Int64  `tfsdk:"daemon_id"`
+}
+
+func NewForgeSiteReverbResource() resource.Resource {
+	return &ForgeSiteReverbResource{}
+}
+
+func (r *ForgeSiteReverbResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_reverb"
+}
+
+func (r *ForgeSiteReverbResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site Reverb resource. This resource enables the Laravel Reverb websocket server on a Forge site and waits until its daemon is running. " +
+			"Changing any setting disables Reverb and enables it again with the new settings.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site to enable Reverb on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The public hostname clients connect to for websockets, e.g. `ws.example.com`.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(8080),
+				MarkdownDescription: "The port the Reverb server listens on. Default is 8080.",
+			},
+			"connections": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1000),
+				MarkdownDescription: "The maximum number of concurrent websocket connections. Default is 1000.",
+			},
+			"daemon_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the daemon Forge created to run Reverb.",
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteReverbResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteReverbResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteReverbResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.enableReverb(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteReverbResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteReverbResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := r.client.CheckReverbStatus(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading reverb status", err.Error())
+		return
+	}
+	if !status.Enabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setForgeSiteReverbState(&state, status)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteReverbResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ForgeSiteReverbResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Reverb settings cannot be changed in place, so restart the integration with the new ones.
+	serverID := int(state.ServerID.ValueInt64())
+	siteID := int(state.SiteID.ValueInt64())
+	if err := r.client.DisableReverb(ctx, serverID, siteID); err != nil {
+		resp.Diagnostics.AddError("Error disabling reverb", err.Error())
+		return
+	}
+	if err := r.client.WaitForReverbToBeDisabled(ctx, serverID, siteID); err != nil {
+		resp.Diagnostics.AddError("Error waiting for reverb to be disabled", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.enableReverb(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteReverbResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeSiteReverbResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DisableReverb(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error disabling reverb", err.Error())
+		return
+	}
+}
+
+func (r *ForgeSiteReverbResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+
+	status, err := r.client.CheckReverbStatus(ctx, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading reverb status", err.Error())
+		return
+	}
+	if !status.Enabled {
+		resp.Diagnostics.AddError("Reverb not enabled", fmt.Sprintf("Reverb is not enabled on site %d of server %d.", siteID, serverID))
+		return
+	}
+
+	var stateModel ForgeSiteReverbResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	setForgeSiteReverbState(&stateModel, status)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// enableReverb enables Reverb with the planned settings and waits until its daemon is running.
+func (r *ForgeSiteReverbResource) enableReverb(ctx context.Context, model *ForgeSiteReverbResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := int(model.ServerID.ValueInt64())
+	siteID := int(model.SiteID.ValueInt64())
+
+	_, err := r.client.EnableReverb(ctx, serverID, siteID, int(model.Port.ValueInt64()), model.Host.ValueString(), int(model.Connections.ValueInt64()))
+	if err != nil {
+		diags.AddError("Error enabling reverb", err.Error())
+		return diags
+	}
+
+	status, err := r.client.WaitForReverbToBeRunning(ctx, serverID, siteID)
+	if err != nil {
+		diags.AddError("Error waiting for reverb to start", err.Error())
+		return diags
+	}
+
+	model.DaemonID = types.Int64Value(int64(status.Daemon.ID))
+	return diags
+}
+
+// setForgeSiteReverbState copies the attributes returned by the API onto the model.
+func setForgeSiteReverbState(model *ForgeSiteReverbResourceModel, status *forge_client.ReverbStatus) {
+	if status.ReverbHost != nil {
+		model.Host = types.StringValue(*status.ReverbHost)
+	}
+	if status.ReverbPort != nil {
+		model.Port = types.Int64Value(int64(*status.ReverbPort))
+	}
+	if status.ReverbConnections != nil {
+		model.Connections = types.Int64Value(int64(*status.ReverbConnections))
+	}
+	if status.Daemon != nil {
+		model.DaemonID = types.Int64Value(int64(status.Daemon.ID))
+	}
+}
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteSchedulerResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteSchedulerResource{}
+
+// ForgeSiteSchedulerResource implements a Terraform resource for the Laravel scheduler integration of a Forge site.
+type ForgeSiteSchedulerResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteSchedulerResourceModel struct {
+	ServerID    types.Int64  `tfsdk:"server_id"`
+	SiteID      types.Int64  `tfsdk:"site_id"`
+	JobID       types.Int64  `tfsdk:"job_id"`
+	Command     types.String `tfsdk:"command"`
+	User        types.String `tfsdk:"user"`
+	Cron        types.String `tfsdk:"cron"`
+	Status      types.String `tfsdk:"status"`
+	NextRunTime types.String `tfsdk:"next_run_time"`
+}
+
+func NewForgeSiteSchedulerResource() resource.Resource {
+	return &ForgeSiteSchedulerResource{}
+}
+
+func (r *ForgeSiteSchedulerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_scheduler"
+}
+
+func (r *ForgeSiteSchedulerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site scheduler resource. This resource enables the Laravel scheduler integration on a Forge site, " +
+			"which runs `php artisan schedule:run` every minute. The site must have Laravel installed.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site to enable the scheduler on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"job_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the scheduled job Forge created for the scheduler.",
+			},
+			"command": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The command the scheduled job runs.",
+			},
+			"user": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user the scheduled job runs as.",
+			},
+			"cron": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The cron expression of the scheduled job.",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"next_run_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The next time the scheduler runs, as of the last refresh.",
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteSchedulerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteSchedulerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteSchedulerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+	siteID := int(plan.SiteID.ValueInt64())
+
+	status, err := r.client.CheckLaravelScheduler(ctx, serverID, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading scheduler status", err.Error())
+		return
+	}
+	if !status.LaravelInstalled {
+		resp.Diagnostics.AddError(
+			"Laravel not installed",
+			fmt.Sprintf("The scheduler can only be enabled on Laravel sites, but Forge does not detect Laravel on site %d of server %d. "+
+				"Install a Laravel application on the site, e.g. with laravel_forge_site_repository, before enabling the scheduler.", siteID, serverID),
+		)
+		return
+	}
+
+	job, err := r.client.EnableLaravelScheduler(ctx, serverID, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error enabling scheduler", err.Error())
+		return
+	}
+
+	setForgeSiteSchedulerState(&plan, job)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteSchedulerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteSchedulerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := r.client.CheckLaravelScheduler(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading scheduler status", err.Error())
+		return
+	}
+	if !status.Enabled || status.Job == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setForgeSiteSchedulerState(&state, status.Job)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteSchedulerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The scheduler has no settings; every attribute forces a replacement.
+	var plan ForgeSiteSchedulerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteSchedulerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeSiteSchedulerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DisableLaravelScheduler(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error disabling scheduler", err.Error())
+		return
+	}
+}
+
+func (r *ForgeSiteSchedulerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+
+	status, err := r.client.CheckLaravelScheduler(ctx, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading scheduler status", err.Error())
+		return
+	}
+	if !status.Enabled || status.Job == nil {
+		resp.Diagnostics.AddError("Scheduler not enabled", fmt.Sprintf("The scheduler is not enabled on site %d of server %d.", siteID, serverID))
+		return
+	}
+
+	var stateModel ForgeSiteSchedulerResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	setForgeSiteSchedulerState(&stateModel, status.Job)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setForgeSiteSchedulerState copies the attributes returned by the API onto the model.
+func setForgeSiteSchedulerState(model *ForgeSiteSchedulerResourceModel, job *forge_client.LaravelSchedulerJob) {
+	model.JobID = types.Int64Value(int64(job.ID))
+	model.Command = types.StringValue(job.Command)
+	model.User = types.StringValue(job.User)
+	model.Cron = types.StringValue(job.Cron)
+	model.Status = types.StringValue(job.Status)
+	model.NextRunTime = types.StringValue(job.NextRunTime)
+}
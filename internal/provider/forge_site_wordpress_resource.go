@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-laravel/internal/forge_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ForgeSiteWordPressResource{}
+var _ resource.ResourceWithImportState = &ForgeSiteWordPressResource{}
+
+// ForgeSiteWordPressResource implements a Terraform resource for a WordPress installation on a Forge site.
+type ForgeSiteWordPressResource struct {
+	client *forge_client.Client
+}
+
+// Resource model.
+type ForgeSiteWordPressResourceModel struct {
+	ServerID       types.Int64  `tfsdk:"server_id"`
+	SiteID         types.Int64  `tfsdk:"site_id"`
+	Database       types.String `tfsdk:"database"`
+	DatabaseUserID types.Int64  `tfsdk:"database_user_id"`
+	AppStatus      types.String `tfsdk:"app_status"`
+}
+
+func NewForgeSiteWordPressResource() resource.Resource {
+	return &ForgeSiteWordPressResource{}
+}
+
+func (r *ForgeSiteWordPressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_site_wordpress"
+}
+
+func (r *ForgeSiteWordPressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forge site WordPress resource. This resource installs WordPress on a Forge site, waits until the installation has finished, " +
+			"and uninstalls it when destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the server the site is on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site to install WordPress on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the database WordPress uses. Imported installations adopt the configured database without being reinstalled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// The API never returns the database, so imported installations have none in state.
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the database reinstalls WordPress unless it was imported.",
+						"Changing the database reinstalls WordPress unless it was imported.",
+					),
+				},
+			},
+			"database_user_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the database user WordPress connects as. Imported installations adopt the configured user without being reinstalled.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+							// The API never returns the database user, so imported installations have none in state.
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the database user reinstalls WordPress unless it was imported.",
+						"Changing the database user reinstalls WordPress unless it was imported.",
+					),
+				},
+			},
+			"app_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The installation status reported by Forge.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ForgeSiteWordPressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Configure Type",
+			fmt.Sprintf("Expected *providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerConfig.Forge == nil {
+		resp.Diagnostics.AddError(
+			"Forge Client Not Configured",
+			"This resource requires the Forge API token to be configured in the provider. "+
+				"Please set the 'forge_api_token' attribute in the provider configuration.",
+		)
+		return
+	}
+
+	r.client = providerConfig.Forge
+}
+
+func (r *ForgeSiteWordPressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ForgeSiteWordPressResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := int(plan.ServerID.ValueInt64())
+	siteID := int(plan.SiteID.ValueInt64())
+
+	payload := forge_client.WordPressInstallRequest{
+		Database: plan.Database.ValueString(),
+		User:     int(plan.DatabaseUserID.ValueInt64()),
+	}
+	if err := r.client.InstallWordPress(ctx, serverID, siteID, payload); err != nil {
+		resp.Diagnostics.AddError("Error installing wordpress", err.Error())
+		return
+	}
+
+	site, err := r.client.WaitForAppToBeInstalled(ctx, serverID, siteID, "wordpress")
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for wordpress to be installed", err.Error())
+		return
+	}
+
+	plan.AppStatus = types.StringPointerValue(site.AppStatus)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteWordPressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ForgeSiteWordPressResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site, err := r.client.GetSite(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading site", err.Error())
+		return
+	}
+	if site.App == nil || !strings.EqualFold(*site.App, "wordpress") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.AppStatus = types.StringPointerValue(site.AppStatus)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteWordPressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No update API exists for WordPress installations; changing the database reinstalls it, except after an import.
+	var plan ForgeSiteWordPressResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ForgeSiteWordPressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ForgeSiteWordPressResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UninstallWordPress(ctx, int(state.ServerID.ValueInt64()), int(state.SiteID.ValueInt64()))
+	if err != nil {
+		if _, ok := err.(*forge_client.ClientErrorResourceNotFound); ok {
+			return
+		}
+		resp.Diagnostics.AddError("Error uninstalling wordpress", err.Error())
+		return
+	}
+}
+
+func (r *ForgeSiteWordPressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect import ID in format "server_id:site_id"
+	parts := splitCompositeID(req.ID, 2)
+	if parts == nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: server_id:site_id")
+		return
+	}
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server_id", err.Error())
+		return
+	}
+	siteID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid site_id", err.Error())
+		return
+	}
+
+	site, err := r.client.GetSite(ctx, int(serverID), int(siteID))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading site", err.Error())
+		return
+	}
+	if site.App == nil || !strings.EqualFold(*site.App, "wordpress") {
+		resp.Diagnostics.AddError("WordPress not installed", fmt.Sprintf("WordPress is not installed on site %d of server %d.", siteID, serverID))
+		return
+	}
+
+	var stateModel ForgeSiteWordPressResourceModel
+	stateModel.ServerID = types.Int64Value(serverID)
+	stateModel.SiteID = types.Int64Value(siteID)
+	// The API does not return the database or its user; they are adopted from the configuration on the next apply.
+	stateModel.Database = types.StringNull()
+	stateModel.DatabaseUserID = types.Int64Null()
+	stateModel.AppStatus = types.StringPointerValue(site.AppStatus)
+
+	diags := resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+}
@@ -143,19 +143,40 @@ func (p *LaravelProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewForgeWorkerResource,
 		NewForgeRecipeResource,
 		NewForgeRecipeRunResource,
-		// NewForgeDaemonResource,
-		// NewForgeFirewallRuleResource,
+		NewForgeDaemonResource,
+		NewForgeFirewallRuleResource,
 		NewForgeSSHKeyResource,
 		NewForgeCertificateResource,
 		NewForgeCertificateSigningRequestResource,
 		NewForgeCertificateSigningRequestInstallationResource,
 		NewForgeScheduledJobResource,
 		NewForgeDeploymentSettingsResource,
-		// NewForgeDatabaseResource,
-		// NewForgeDatabaseUserResource,
-		// NewForgeNginxTemplateResource,
-		// NewForgeRedirectRuleResource,
-		// NewForgeMonitorResource,
+		NewForgeDatabaseResource,
+		NewForgeDatabaseUserResource,
+		NewForgeNginxTemplateResource,
+		NewForgeRedirectRuleResource,
+		NewForgeMonitorResource,
+		NewForgeSecurityRuleResource,
+		NewForgeBackupConfigurationResource,
+		NewForgeSiteRepositoryResource,
+		NewForgeSiteDeployKeyResource,
+		NewForgeSiteWebhookResource,
+		NewForgeSiteNginxConfigResource,
+		NewForgeSiteEnvResource,
+		NewForgeSiteOctaneResource,
+		NewForgeSiteReverbResource,
+		NewForgeSiteIntegrationResource,
+		NewForgeSiteSchedulerResource,
+		NewForgeSiteMaintenanceResource,
+		NewForgeSiteComposerAuthResource,
+		NewForgeServerPHPResource,
+		NewForgeServerServiceResource,
+		NewForgeServerBlackfireResource,
+		NewForgeServerPapertrailResource,
+		NewForgeSiteBalancingResource,
+		NewForgeSiteWordPressResource,
+		NewForgeSitePhpMyAdminResource,
+		NewForgeSiteCommandResource,
 	}
 }
 
@@ -169,6 +190,7 @@ func (p *LaravelProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewEnvoyerServersDataSource,
 		NewEnvoyerActionsDataSource,
 		NewForgeCredentialsDataSource,
+		NewForgeNginxTemplateDataSource,
 		// NewForgeServersDataSource,
 		// NewForgeSitesDataSource,
 		// NewForgePHPVersionsDataSource,